@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -8,16 +9,37 @@ import (
 	"net/http"
 	"os"
 	"text/tabwriter"
+	"time"
 )
 
 const (
 	defaultBaseURL = "http://localhost:5000"
 )
 
+// jsonOutput switches all commands to machine-readable JSON on stdout.
+var jsonOutput bool
+
+// httpClient is shared by all commands so every request gets the
+// configured timeout.
+var httpClient *http.Client
+
+// maxRetries is how many times a failed connection is retried before
+// giving up.
+const maxRetries = 2
+
 func main() {
 	var baseURL string
+	var timeout time.Duration
+	var prompt string
+	var model string
 	flag.StringVar(&baseURL, "url", defaultBaseURL, "ecco9 server URL")
+	flag.BoolVar(&jsonOutput, "json", false, "emit raw JSON instead of formatted output")
+	flag.DurationVar(&timeout, "timeout", 10*time.Second, "HTTP request timeout")
+	flag.StringVar(&prompt, "prompt", "", "prompt for the generate command (reads stdin if omitted)")
+	flag.StringVar(&model, "model", "", "model for the generate command (server default if omitted)")
 	flag.Parse()
+
+	httpClient = &http.Client{Timeout: timeout}
 	
 	if len(flag.Args()) < 1 {
 		printUsage()
@@ -35,6 +57,20 @@ func main() {
 		showDrivers(baseURL)
 	case "health":
 		showHealth(baseURL)
+	case "goals":
+		showGoals(baseURL)
+	case "skills":
+		showSkills(baseURL)
+	case "wisdom":
+		showWisdom(baseURL)
+	case "generate":
+		runGenerate(baseURL, prompt, model)
+	case "device":
+		if len(flag.Args()) < 2 {
+			fmt.Println("Error: device command requires a device id")
+			os.Exit(1)
+		}
+		showDevice(baseURL, flag.Args()[1])
 	default:
 		fmt.Printf("Unknown command: %s\n\n", command)
 		printUsage()
@@ -49,19 +85,115 @@ func printUsage() {
 	fmt.Println("\nCommands:")
 	fmt.Println("  status    Show platform status")
 	fmt.Println("  devices   List all cognitive devices")
+	fmt.Println("  device    Show full state of a single device by id")
 	fmt.Println("  drivers   List all device drivers")
 	fmt.Println("  health    Check platform health")
+	fmt.Println("  goals     List active goals from the cognitive layer")
+	fmt.Println("  skills    List skill levels and practice trends")
+	fmt.Println("  wisdom    List top wisdom entries by success rate")
+	fmt.Println("  generate  Send a prompt to the generation endpoint")
 	fmt.Println("\nFlags:")
 	fmt.Println("  -url string")
 	fmt.Println("        ecco9 server URL (default: http://localhost:5000)")
+	fmt.Println("  -json")
+	fmt.Println("        emit raw JSON instead of formatted output")
+	fmt.Println("  -timeout duration")
+	fmt.Println("        HTTP request timeout (default: 10s)")
 	fmt.Println("\nExamples:")
 	fmt.Println("  ecco9-admin status")
 	fmt.Println("  ecco9-admin devices")
 	fmt.Println("  ecco9-admin -url http://remote:5000 health")
+	fmt.Println("  ecco9-admin -json devices | jq '.devices[].id'")
+	fmt.Println("  ecco9-admin -prompt \"hello\" generate")
+}
+
+func runGenerate(baseURL, prompt, model string) {
+	// Read the prompt from stdin when no -prompt flag is given
+	if prompt == "" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Printf("Error: Failed to read prompt from stdin: %v\n", err)
+			os.Exit(1)
+		}
+		prompt = string(data)
+	}
+
+	if prompt == "" {
+		fmt.Println("Error: No prompt provided (use -prompt or pipe via stdin)")
+		os.Exit(1)
+	}
+
+	payload := map[string]string{"prompt": prompt}
+	if model != "" {
+		payload["model"] = model
+	}
+
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		fmt.Printf("Error: Failed to encode request: %v\n", err)
+		os.Exit(1)
+	}
+
+	resp, err := httpClient.Post(baseURL+"/api/generate", "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		fmt.Printf("Error: Generation failed (status %d): %s\n", resp.StatusCode, string(body))
+		os.Exit(1)
+	}
+
+	var result struct {
+		Response string `json:"response"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		fmt.Printf("Error: Failed to parse response: %v\n", err)
+		os.Exit(1)
+	}
+
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
+	fmt.Println(result.Response)
+}
+
+// getWithRetry performs an HTTP GET through the shared client, retrying
+// transient connection errors with a short backoff.
+func getWithRetry(url string) (*http.Response, error) {
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * 500 * time.Millisecond)
+		}
+		resp, err := httpClient.Get(url)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// printJSON writes v to stdout as indented JSON for script consumption.
+func printJSON(v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		fmt.Printf("Error: Failed to encode JSON: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
 }
 
 func showStatus(baseURL string) {
-	resp, err := http.Get(baseURL + "/api/status")
+	resp, err := getWithRetry(baseURL + "/api/status")
 	if err != nil {
 		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
 		os.Exit(1)
@@ -79,7 +211,12 @@ func showStatus(baseURL string) {
 		fmt.Printf("Error: Failed to parse response: %v\n", err)
 		os.Exit(1)
 	}
-	
+
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
 	fmt.Println("🌊 ecco9 Platform Status")
 	fmt.Println("========================")
 	fmt.Printf("Platform:         %s\n", result.Platform)
@@ -107,7 +244,7 @@ func showStatus(baseURL string) {
 }
 
 func showDevices(baseURL string) {
-	resp, err := http.Get(baseURL + "/api/devices")
+	resp, err := getWithRetry(baseURL + "/api/devices")
 	if err != nil {
 		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
 		os.Exit(1)
@@ -125,6 +262,11 @@ func showDevices(baseURL string) {
 		os.Exit(1)
 	}
 	
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
 	fmt.Println("📍 Cognitive Devices")
 	fmt.Println("====================")
 	
@@ -145,8 +287,246 @@ func showDevices(baseURL string) {
 	fmt.Printf("\nTotal devices: %d\n", len(result.Devices))
 }
 
+func showDevice(baseURL, deviceID string) {
+	resp, err := getWithRetry(baseURL + "/api/devices/" + deviceID)
+	if err != nil {
+		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusNotFound {
+		fmt.Printf("Error: Device not found: %s\n", deviceID)
+		os.Exit(1)
+	}
+	if resp.StatusCode != http.StatusOK {
+		fmt.Printf("Error: Server returned status %d: %s\n", resp.StatusCode, string(body))
+		os.Exit(1)
+	}
+
+	var result struct {
+		Device map[string]interface{} `json:"device"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		fmt.Printf("Error: Failed to parse response: %v\n", err)
+		os.Exit(1)
+	}
+
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
+	device := result.Device
+
+	fmt.Printf("📍 Device: %s\n", deviceID)
+	fmt.Println("====================")
+	fmt.Printf("ID:         %v\n", device["id"])
+	fmt.Printf("Name:       %v\n", device["name"])
+	fmt.Printf("Status:     %v\n", device["status"])
+	fmt.Printf("Health:     %v\n", device["health"])
+	fmt.Printf("Power:      %v\n", device["power"])
+
+	if uptimeNs, ok := device["uptime"].(float64); ok {
+		fmt.Printf("Uptime:     %v\n", time.Duration(uptimeNs).Round(time.Second))
+	}
+	if lastUpdate, ok := device["last_update"].(string); ok {
+		fmt.Printf("Updated:    %s\n", lastUpdate)
+	}
+
+	if metrics, ok := device["metrics"].(map[string]interface{}); ok {
+		fmt.Println("\nMetrics:")
+		fmt.Printf("  Operations:      %v\n", metrics["operation_count"])
+		fmt.Printf("  Errors:          %v\n", metrics["error_count"])
+		if latencyNs, ok := metrics["average_latency"].(float64); ok {
+			fmt.Printf("  Avg Latency:     %v\n", time.Duration(latencyNs))
+		}
+		if lastOp, ok := metrics["last_operation"].(string); ok {
+			fmt.Printf("  Last Operation:  %s\n", lastOp)
+		}
+		fmt.Printf("  CPU Usage:       %v\n", metrics["cpu_usage"])
+		fmt.Printf("  Memory Usage:    %v\n", metrics["memory_usage"])
+	}
+}
+
+func showGoals(baseURL string) {
+	resp, err := getWithRetry(baseURL + "/api/goals")
+	if err != nil {
+		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	var result struct {
+		Goals []struct {
+			ID       string  `json:"id"`
+			Title    string  `json:"title"`
+			Category string  `json:"category"`
+			Priority int     `json:"priority"`
+			Status   string  `json:"status"`
+			Progress float64 `json:"progress"`
+		} `json:"goals"`
+		Metrics map[string]interface{} `json:"metrics"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		fmt.Printf("Error: Failed to parse response: %v\n", err)
+		os.Exit(1)
+	}
+
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
+	fmt.Println("🎯 Active Goals")
+	fmt.Println("===============")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TITLE\tCATEGORY\tSTATUS\tPRIORITY\tPROGRESS")
+	fmt.Fprintln(w, "─────\t────────\t──────\t────────\t────────")
+
+	for _, goal := range result.Goals {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%.0f%%\n",
+			goal.Title,
+			goal.Category,
+			goal.Status,
+			goal.Priority,
+			goal.Progress*100)
+	}
+
+	w.Flush()
+	fmt.Printf("\nTotal active goals: %d\n", len(result.Goals))
+}
+
+func showSkills(baseURL string) {
+	resp, err := getWithRetry(baseURL + "/api/skills")
+	if err != nil {
+		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	var result struct {
+		Skills []struct {
+			Name         string  `json:"name"`
+			Category     string  `json:"category"`
+			CurrentLevel float64 `json:"current_level"`
+			TargetLevel  float64 `json:"target_level"`
+			Trend        float64 `json:"trend"`
+		} `json:"skills"`
+		SessionsCompleted uint64 `json:"sessions_completed"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		fmt.Printf("Error: Failed to parse response: %v\n", err)
+		os.Exit(1)
+	}
+
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
+	fmt.Println("📚 Skill Levels")
+	fmt.Println("===============")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCATEGORY\tLEVEL\tTARGET\tTREND")
+	fmt.Fprintln(w, "────\t────────\t─────\t──────\t─────")
+
+	for _, skill := range result.Skills {
+		fmt.Fprintf(w, "%s\t%s\t%.2f\t%.2f\t%s\n",
+			skill.Name,
+			skill.Category,
+			skill.CurrentLevel,
+			skill.TargetLevel,
+			formatTrend(skill.Trend))
+	}
+
+	w.Flush()
+	fmt.Printf("\nTotal skills: %d (practice sessions: %d)\n", len(result.Skills), result.SessionsCompleted)
+}
+
+// formatTrend renders a performance trend as a direction arrow with the
+// raw slope
+func formatTrend(trend float64) string {
+	switch {
+	case trend > 0.01:
+		return fmt.Sprintf("↑ %+.3f", trend)
+	case trend < -0.01:
+		return fmt.Sprintf("↓ %+.3f", trend)
+	default:
+		return "→"
+	}
+}
+
+func showWisdom(baseURL string) {
+	resp, err := getWithRetry(baseURL + "/api/wisdom")
+	if err != nil {
+		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	var result struct {
+		Wisdom []struct {
+			Content      string  `json:"content"`
+			Category     string  `json:"category"`
+			SuccessRate  float64 `json:"success_rate"`
+			TimesApplied uint64  `json:"times_applied"`
+		} `json:"wisdom"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		fmt.Printf("Error: Failed to parse response: %v\n", err)
+		os.Exit(1)
+	}
+
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
+	fmt.Println("🦉 Top Wisdom")
+	fmt.Println("=============")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CATEGORY\tAPPLIED\tSUCCESS\tCONTENT")
+	fmt.Fprintln(w, "────────\t───────\t───────\t───────")
+
+	for _, entry := range result.Wisdom {
+		fmt.Fprintf(w, "%s\t%d\t%.0f%%\t%s\n",
+			entry.Category,
+			entry.TimesApplied,
+			entry.SuccessRate*100,
+			previewContent(entry.Content, 60))
+	}
+
+	w.Flush()
+	fmt.Printf("\nTotal entries: %d\n", len(result.Wisdom))
+}
+
+// previewContent truncates wisdom content to fit one table cell
+func previewContent(content string, max int) string {
+	runes := []rune(content)
+	if len(runes) <= max {
+		return content
+	}
+	return string(runes[:max-1]) + "…"
+}
+
 func showDrivers(baseURL string) {
-	resp, err := http.Get(baseURL + "/api/drivers")
+	resp, err := getWithRetry(baseURL + "/api/drivers")
 	if err != nil {
 		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
 		os.Exit(1)
@@ -164,6 +544,11 @@ func showDrivers(baseURL string) {
 		os.Exit(1)
 	}
 	
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
 	fmt.Println("🔧 Device Drivers")
 	fmt.Println("=================")
 	
@@ -182,7 +567,7 @@ func showDrivers(baseURL string) {
 }
 
 func showHealth(baseURL string) {
-	resp, err := http.Get(baseURL + "/api/health")
+	resp, err := getWithRetry(baseURL + "/api/health")
 	if err != nil {
 		fmt.Printf("Error: Failed to connect to ecco9 server: %v\n", err)
 		os.Exit(1)
@@ -201,6 +586,14 @@ func showHealth(baseURL string) {
 		os.Exit(1)
 	}
 	
+	if jsonOutput {
+		printJSON(result)
+		if !result.Healthy {
+			os.Exit(1)
+		}
+		return
+	}
+
 	fmt.Println("🏥 Platform Health Check")
 	fmt.Println("========================")
 	
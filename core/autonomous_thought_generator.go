@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ type ThoughtGenerator struct {
 	interestPatterns  map[string]float64
 	thoughtHistory    []GeneratedThought
 	maxHistorySize    int
+	inputTokenBudget  int
 	generationCount   int64
 }
 
@@ -36,6 +38,10 @@ type GeneratedThought struct {
 	Importance float64
 }
 
+// defaultInputTokenBudget caps contextual prompt size so long sessions
+// cannot push the prompt past a model's context window
+const defaultInputTokenBudget = 2048
+
 // NewThoughtGenerator creates a new thought generator
 func NewThoughtGenerator(llmProvider LLMProvider) *ThoughtGenerator {
 	return &ThoughtGenerator{
@@ -44,7 +50,28 @@ func NewThoughtGenerator(llmProvider LLMProvider) *ThoughtGenerator {
 		interestPatterns: make(map[string]float64),
 		thoughtHistory:   make([]GeneratedThought, 0),
 		maxHistorySize:   100,
+		inputTokenBudget: defaultInputTokenBudget,
+	}
+}
+
+// SetInputTokenBudget adjusts the prompt token budget; non-positive
+// values restore the default
+func (tg *ThoughtGenerator) SetInputTokenBudget(budget int) {
+	tg.mu.Lock()
+	defer tg.mu.Unlock()
+
+	if budget <= 0 {
+		budget = defaultInputTokenBudget
 	}
+	tg.inputTokenBudget = budget
+}
+
+// PromptAssembly is a budget-trimmed prompt plus an account of what was
+// dropped to make it fit
+type PromptAssembly struct {
+	Prompt          string
+	EstimatedTokens int
+	TrimmedEntries  int // Oldest context entries dropped to fit the budget
 }
 
 // GenerateAutonomousThought generates a thought based on current context
@@ -56,14 +83,14 @@ func (tg *ThoughtGenerator) GenerateAutonomousThought() (*GeneratedThought, erro
 	}
 	tg.mu.RUnlock()
 	
-	// Build context-aware prompt
-	prompt := tg.buildContextualPrompt()
-	
+	// Build context-aware prompt within the token budget
+	assembly := tg.buildContextualPrompt()
+
 	// Generate thought
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
-	
-	content, err := tg.llmProvider.GenerateThought(ctx, prompt)
+
+	content, err := tg.llmProvider.GenerateThought(ctx, assembly.Prompt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate thought: %w", err)
 	}
@@ -107,14 +134,14 @@ func (tg *ThoughtGenerator) GenerateReflection() (*GeneratedThought, error) {
 		return nil, fmt.Errorf("no recent thoughts to reflect on")
 	}
 	
-	// Build reflection context
-	contextStr := tg.buildReflectionContext(recentThoughts)
-	
+	// Build reflection context within the token budget
+	assembly := tg.buildReflectionContext(recentThoughts)
+
 	// Generate reflection
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
-	
-	content, err := tg.llmProvider.GenerateReflection(ctx, contextStr)
+
+	content, err := tg.llmProvider.GenerateReflection(ctx, assembly.Prompt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate reflection: %w", err)
 	}
@@ -138,46 +165,102 @@ func (tg *ThoughtGenerator) GenerateReflection() (*GeneratedThought, error) {
 	return thought, nil
 }
 
-// buildContextualPrompt builds a prompt based on current context
-func (tg *ThoughtGenerator) buildContextualPrompt() string {
+// buildContextualPrompt builds a prompt based on current context,
+// trimming the oldest working-memory entries so the base prompt and the
+// most recent items always fit within the token budget
+func (tg *ThoughtGenerator) buildContextualPrompt() PromptAssembly {
 	tg.mu.RLock()
 	defer tg.mu.RUnlock()
-	
+
+	// The base prompt and interests are never trimmed
+	basePrompt := "Generate a new thought that builds on this context, explores a curiosity, or offers a fresh insight."
+
+	interestLine := ""
+	if len(tg.interestPatterns) > 0 {
+		interests := tg.getTopInterests(3)
+		interestLine = "Current interests: " + strings.Join(interests, ", ") + "\n\n"
+	}
+
+	memoryHeader := "Recent thoughts in working memory:\n"
+	used := estimatePromptTokens(basePrompt) + estimatePromptTokens(interestLine) +
+		estimatePromptTokens(memoryHeader)
+	kept, trimmed := trimToBudget(tg.workingMemory, tg.inputTokenBudget-used)
+
 	var sb strings.Builder
-	
-	// Add working memory context
-	if len(tg.workingMemory) > 0 {
-		sb.WriteString("Recent thoughts in working memory:\n")
-		for i, mem := range tg.workingMemory {
+	if len(kept) > 0 {
+		sb.WriteString(memoryHeader)
+		for i, mem := range kept {
 			sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, mem))
 		}
 		sb.WriteString("\n")
 	}
-	
-	// Add interest patterns
-	if len(tg.interestPatterns) > 0 {
-		sb.WriteString("Current interests: ")
-		interests := tg.getTopInterests(3)
-		sb.WriteString(strings.Join(interests, ", "))
-		sb.WriteString("\n\n")
+	sb.WriteString(interestLine)
+	sb.WriteString(basePrompt)
+
+	prompt := sb.String()
+	return PromptAssembly{
+		Prompt:          prompt,
+		EstimatedTokens: estimatePromptTokens(prompt),
+		TrimmedEntries:  trimmed,
 	}
-	
-	// Add generation prompt
-	sb.WriteString("Generate a new thought that builds on this context, explores a curiosity, or offers a fresh insight.")
-	
-	return sb.String()
 }
 
-// buildReflectionContext builds context for reflection
-func (tg *ThoughtGenerator) buildReflectionContext(recentThoughts []GeneratedThought) string {
-	var sb strings.Builder
-	
-	sb.WriteString("Recent thoughts:\n")
+// buildReflectionContext builds context for reflection, dropping the
+// oldest thoughts if they would exceed the token budget
+func (tg *ThoughtGenerator) buildReflectionContext(recentThoughts []GeneratedThought) PromptAssembly {
+	tg.mu.RLock()
+	budget := tg.inputTokenBudget
+	tg.mu.RUnlock()
+
+	header := "Recent thoughts:\n"
+	lines := make([]string, len(recentThoughts))
 	for i, thought := range recentThoughts {
-		sb.WriteString(fmt.Sprintf("%d. [%s] %s\n", i+1, thought.Type, thought.Content))
+		lines[i] = fmt.Sprintf("[%s] %s", thought.Type, thought.Content)
 	}
-	
-	return sb.String()
+
+	kept, trimmed := trimToBudget(lines, budget-estimatePromptTokens(header))
+
+	var sb strings.Builder
+	sb.WriteString(header)
+	for i, line := range kept {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, line))
+	}
+
+	prompt := sb.String()
+	return PromptAssembly{
+		Prompt:          prompt,
+		EstimatedTokens: estimatePromptTokens(prompt),
+		TrimmedEntries:  trimmed,
+	}
+}
+
+// estimatePromptTokens approximates token count at four characters per
+// token, matching the server-side usage estimate
+func estimatePromptTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+// trimToBudget keeps the newest entries that fit the budget, preserving
+// chronological order, and reports how many of the oldest were dropped
+func trimToBudget(entries []string, budget int) ([]string, int) {
+	kept := make([]string, 0, len(entries))
+	used := 0
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		cost := estimatePromptTokens(entries[i]) + 2 // Numbering and newline overhead
+		if used+cost > budget {
+			break
+		}
+		used += cost
+		kept = append(kept, entries[i])
+	}
+
+	// Restore chronological order
+	for i, j := 0, len(kept)-1; i < j; i, j = i+1, j-1 {
+		kept[i], kept[j] = kept[j], kept[i]
+	}
+
+	return kept, len(entries) - len(kept)
 }
 
 // determineThoughtType analyzes content to determine thought type
@@ -295,22 +378,24 @@ func (tg *ThoughtGenerator) AddInterest(topic string, strength float64) {
 	tg.interestPatterns[topic] = strength
 }
 
-// getTopInterests returns top N interests
+// getTopInterests returns top N interests by strength
 func (tg *ThoughtGenerator) getTopInterests(n int) []string {
 	if len(tg.interestPatterns) == 0 {
 		return []string{}
 	}
-	
-	// Simple selection of top interests
-	interests := make([]string, 0, n)
+
+	topics := make([]string, 0, len(tg.interestPatterns))
 	for topic := range tg.interestPatterns {
-		interests = append(interests, topic)
-		if len(interests) >= n {
-			break
-		}
+		topics = append(topics, topic)
 	}
-	
-	return interests
+	sort.Slice(topics, func(i, j int) bool {
+		return tg.interestPatterns[topics[i]] > tg.interestPatterns[topics[j]]
+	})
+
+	if len(topics) > n {
+		topics = topics[:n]
+	}
+	return topics
 }
 
 // GetStats returns generation statistics
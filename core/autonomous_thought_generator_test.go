@@ -0,0 +1,113 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContextualPromptTrimsOldestEntriesFirst(t *testing.T) {
+	tg := NewThoughtGenerator(nil)
+
+	entries := make([]string, 5)
+	for i := range entries {
+		entries[i] = fmt.Sprintf("memory-%d-%s", i+1, strings.Repeat("x", 30))
+		tg.updateWorkingMemory(entries[i])
+	}
+
+	// Budget fits the base prompt, the section header, and exactly the
+	// two newest entries
+	base := "Generate a new thought that builds on this context, explores a curiosity, or offers a fresh insight."
+	header := "Recent thoughts in working memory:\n"
+	budget := estimatePromptTokens(base) + estimatePromptTokens(header) +
+		2*(estimatePromptTokens(entries[0])+2)
+	tg.SetInputTokenBudget(budget)
+
+	assembly := tg.buildContextualPrompt()
+
+	if assembly.TrimmedEntries != 3 {
+		t.Errorf("three oldest entries should be trimmed, got %d", assembly.TrimmedEntries)
+	}
+	for _, old := range entries[:3] {
+		if strings.Contains(assembly.Prompt, old) {
+			t.Errorf("trimmed entry %q should not appear in prompt", old)
+		}
+	}
+	for _, recent := range entries[3:] {
+		if !strings.Contains(assembly.Prompt, recent) {
+			t.Errorf("recent entry %q should survive trimming", recent)
+		}
+	}
+
+	// Kept entries stay in chronological order
+	if strings.Index(assembly.Prompt, entries[3]) > strings.Index(assembly.Prompt, entries[4]) {
+		t.Error("kept entries should remain in chronological order")
+	}
+	if !strings.Contains(assembly.Prompt, base) {
+		t.Error("base prompt must never be trimmed")
+	}
+	if assembly.EstimatedTokens > budget {
+		t.Errorf("assembled prompt exceeds budget: %d > %d", assembly.EstimatedTokens, budget)
+	}
+}
+
+func TestContextualPromptKeepsBasePromptUnderTinyBudget(t *testing.T) {
+	tg := NewThoughtGenerator(nil)
+	tg.updateWorkingMemory(strings.Repeat("y", 200))
+	tg.SetInputTokenBudget(10)
+
+	assembly := tg.buildContextualPrompt()
+
+	if assembly.TrimmedEntries != 1 {
+		t.Errorf("the lone entry should be trimmed, got %d", assembly.TrimmedEntries)
+	}
+	if !strings.Contains(assembly.Prompt, "Generate a new thought") {
+		t.Error("base prompt must survive even a tiny budget")
+	}
+	if strings.Contains(assembly.Prompt, "working memory") {
+		t.Error("empty context should omit the working memory header")
+	}
+}
+
+func TestContextualPromptUntrimmedWithinBudget(t *testing.T) {
+	tg := NewThoughtGenerator(nil)
+	tg.updateWorkingMemory("a small thought")
+	tg.updateWorkingMemory("another small thought")
+
+	assembly := tg.buildContextualPrompt()
+
+	if assembly.TrimmedEntries != 0 {
+		t.Errorf("nothing should be trimmed under the default budget, got %d", assembly.TrimmedEntries)
+	}
+	if !strings.Contains(assembly.Prompt, "a small thought") ||
+		!strings.Contains(assembly.Prompt, "another small thought") {
+		t.Error("all entries should appear when the budget allows")
+	}
+}
+
+func TestReflectionContextTrimsOldestThoughts(t *testing.T) {
+	tg := NewThoughtGenerator(nil)
+
+	thoughts := make([]GeneratedThought, 4)
+	for i := range thoughts {
+		thoughts[i] = GeneratedThought{
+			Content:   fmt.Sprintf("thought-%d-%s", i+1, strings.Repeat("z", 40)),
+			Type:      "observation",
+			Timestamp: time.Now(),
+		}
+	}
+
+	tg.SetInputTokenBudget(40)
+	assembly := tg.buildReflectionContext(thoughts)
+
+	if assembly.TrimmedEntries == 0 {
+		t.Fatal("oversized reflection context should be trimmed")
+	}
+	if !strings.Contains(assembly.Prompt, thoughts[3].Content) {
+		t.Error("the newest thought should survive trimming")
+	}
+	if strings.Contains(assembly.Prompt, thoughts[0].Content) {
+		t.Error("the oldest thought should be trimmed first")
+	}
+}
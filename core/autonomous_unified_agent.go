@@ -71,7 +71,8 @@ type CognitiveGoal struct {
 type ConsciousnessStream struct {
 	mu                sync.RWMutex
 	ctx               context.Context
-	
+	cancel            context.CancelFunc
+
 	// LLM provider for thought generation
 	llmProvider       llm.Provider
 	
@@ -554,7 +555,7 @@ func (cs *ConsciousnessStream) Start() error {
 		cs.mu.Unlock()
 		return fmt.Errorf("consciousness stream already active")
 	}
-	cs.ctx, _ = context.WithCancel(context.Background())
+	cs.ctx, cs.cancel = context.WithCancel(context.Background())
 	cs.streamActive = true
 	cs.mu.Unlock()
 	
@@ -570,8 +571,11 @@ func (cs *ConsciousnessStream) Stop() error {
 	if !cs.streamActive {
 		return fmt.Errorf("consciousness stream not active")
 	}
-	
+
 	cs.streamActive = false
+	if cs.cancel != nil {
+		cs.cancel()
+	}
 	return nil
 }
 
@@ -625,11 +629,10 @@ func (cs *ConsciousnessStream) generateThought() {
 	}
 	cs.mu.RUnlock()
 	
-	// Generate autonomous thought using LLM
-	prompt := fmt.Sprintf("Continue this stream of consciousness with a brief, introspective thought: %s", context)
-	
-	// In real implementation, would call LLM API
+	// In real implementation, would prompt the LLM to continue the stream
+	// of consciousness from the recent-thought context built above.
 	// For now, generate placeholder thought
+	_ = context
 	thought := fmt.Sprintf("Autonomous thought at %s: Contemplating cognitive patterns...", time.Now().Format("15:04:05"))
 	
 	cs.mu.Lock()
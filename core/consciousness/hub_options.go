@@ -0,0 +1,40 @@
+package consciousness
+
+import (
+	"time"
+)
+
+// SendPolicy controls what happens when a layer's queue is full
+type SendPolicy int
+
+const (
+	// PolicyBlock waits up to SendTimeout for space, then drops the
+	// incoming message
+	PolicyBlock SendPolicy = iota
+	// PolicyDropOldest evicts the oldest queued message to make room
+	PolicyDropOldest
+	// PolicyDropNewest rejects the incoming message outright
+	PolicyDropNewest
+)
+
+func (p SendPolicy) String() string {
+	return [...]string{"block", "drop_oldest", "drop_newest"}[p]
+}
+
+// HubOptions configures queue capacity and backpressure behavior
+type HubOptions struct {
+	QueueCapacity int           // Per-layer queue slots (0 = unbounded)
+	SendPolicy    SendPolicy    // Behavior when a queue is full
+	SendTimeout   time.Duration // Max wait under PolicyBlock
+	LogDrops      bool          // Print a warning when a message is dropped
+}
+
+// DefaultHubOptions preserves the hub's historical behavior: 100 slots
+// per layer, blocking sends with a short timeout
+func DefaultHubOptions() HubOptions {
+	return HubOptions{
+		QueueCapacity: 100,
+		SendPolicy:    PolicyBlock,
+		SendTimeout:   5 * time.Second,
+	}
+}
@@ -0,0 +1,143 @@
+package consciousness
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// saturateLayer fills the basic queue while its dispatcher is pinned on
+// a gated primer message, returning the handler and gate
+func saturateLayer(t *testing.T, hub *LayerCommunicationHub, n int) *gatedHandler {
+	t.Helper()
+
+	handler := &gatedHandler{entered: make(chan struct{}), gate: make(chan struct{})}
+	hub.RegisterHandler(LayerBasic, handler)
+
+	if err := hub.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	primer := CreateMessage(LayerReflective, LayerBasic, MessagePerception, "primer", 0.5)
+	if err := hub.SendMessage(primer); err != nil {
+		t.Fatalf("SendMessage failed: %v", err)
+	}
+	<-handler.entered
+
+	for i := 0; i < n; i++ {
+		msg := CreateMessage(LayerReflective, LayerBasic, MessagePerception, fmt.Sprintf("msg-%d", i), 0.5)
+		// Stagger timestamps so arrival order is unambiguous
+		msg.Timestamp = msg.Timestamp.Add(time.Duration(i) * time.Millisecond)
+		if err := hub.SendMessage(msg); err != nil {
+			t.Fatalf("filling message %d rejected: %v", i, err)
+		}
+	}
+
+	return handler
+}
+
+func TestDropOldestKeepsNewest(t *testing.T) {
+	hub := NewLayerCommunicationHub(HubOptions{
+		QueueCapacity: 3,
+		SendPolicy:    PolicyDropOldest,
+	})
+	defer hub.Stop()
+
+	handler := saturateLayer(t, hub, 3)
+
+	// One more message evicts the oldest queued one (msg-0)
+	extra := CreateMessage(LayerReflective, LayerBasic, MessagePerception, "newest", 0.5)
+	extra.Timestamp = extra.Timestamp.Add(time.Second)
+	if err := hub.SendMessage(extra); err != nil {
+		t.Fatalf("drop-oldest should accept the newest message: %v", err)
+	}
+
+	if got := hub.GetMetrics()["messages_dropped"].(uint64); got != 1 {
+		t.Errorf("one drop expected, got %d", got)
+	}
+
+	close(handler.gate)
+	waitFor(t, func() bool { return handler.count() == 4 }, "queue drain")
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	for _, msg := range handler.received {
+		if msg.Content == "msg-0" {
+			t.Error("oldest message should have been evicted")
+		}
+	}
+}
+
+func TestDropNewestRejectsIncoming(t *testing.T) {
+	hub := NewLayerCommunicationHub(HubOptions{
+		QueueCapacity: 2,
+		SendPolicy:    PolicyDropNewest,
+	})
+	defer hub.Stop()
+
+	handler := saturateLayer(t, hub, 2)
+
+	extra := CreateMessage(LayerReflective, LayerBasic, MessagePerception, "overflow", 0.9)
+	if err := hub.SendMessage(extra); err == nil {
+		t.Error("drop-newest should reject messages when full")
+	}
+	if got := hub.GetMetrics()["messages_dropped"].(uint64); got != 1 {
+		t.Errorf("one drop expected, got %d", got)
+	}
+
+	close(handler.gate)
+	waitFor(t, func() bool { return handler.count() == 3 }, "queue drain")
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	for _, msg := range handler.received {
+		if msg.Content == "overflow" {
+			t.Error("rejected message should not be delivered")
+		}
+	}
+}
+
+func TestBlockPolicyTimesOut(t *testing.T) {
+	hub := NewLayerCommunicationHub(HubOptions{
+		QueueCapacity: 1,
+		SendPolicy:    PolicyBlock,
+		SendTimeout:   50 * time.Millisecond,
+	})
+	defer hub.Stop()
+
+	handler := saturateLayer(t, hub, 1)
+	defer close(handler.gate)
+
+	start := time.Now()
+	extra := CreateMessage(LayerReflective, LayerBasic, MessagePerception, "blocked", 0.5)
+	err := hub.SendMessage(extra)
+	if err == nil {
+		t.Error("blocked send should time out on a saturated queue")
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("send should have waited for the timeout, returned after %v", elapsed)
+	}
+}
+
+func TestGetLayerStateExposesQueueDepth(t *testing.T) {
+	hub := NewLayerCommunicationHub(HubOptions{
+		QueueCapacity: 5,
+		SendPolicy:    PolicyDropNewest,
+	})
+	defer hub.Stop()
+
+	handler := saturateLayer(t, hub, 3)
+	defer close(handler.gate)
+
+	state := hub.GetLayerState(LayerBasic)
+	if state["queue_depth"].(int) != 3 {
+		t.Errorf("queue depth wrong: %v", state["queue_depth"])
+	}
+	if state["queue_dropped"].(uint64) != 0 {
+		t.Errorf("no drops expected yet: %v", state["queue_dropped"])
+	}
+
+	if hub.GetLayerState(LayerIdentifier("dreaming")) != nil {
+		t.Error("unknown layer should yield nil state")
+	}
+}
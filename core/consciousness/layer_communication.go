@@ -56,10 +56,10 @@ type LayerCommunicationHub struct {
 	ctx             context.Context
 	cancel          context.CancelFunc
 	
-	// Message channels for each layer
-	basicChannel      chan *LayerMessage
-	reflectiveChannel chan *LayerMessage
-	metaCogChannel    chan *LayerMessage
+	// Priority message queues for each layer
+	basicQueue      *layerQueue
+	reflectiveQueue *layerQueue
+	metaCogQueue    *layerQueue
 	
 	// Message history
 	messageHistory    []*LayerMessage
@@ -70,6 +70,13 @@ type LayerCommunicationHub struct {
 	reflectiveHandler LayerHandler
 	metaCogHandler    LayerHandler
 	
+	// Emergence tracking
+	emergentPatterns  []EmergentPattern
+	lastEmergenceScan time.Time
+
+	// Options
+	opts              HubOptions
+
 	// Metrics
 	messagesProcessed uint64
 	emergenceDetected uint64
@@ -84,16 +91,24 @@ type LayerHandler interface {
 	GetLayerState() map[string]interface{}
 }
 
-// NewLayerCommunicationHub creates a new inter-layer communication system
-func NewLayerCommunicationHub() *LayerCommunicationHub {
+// NewLayerCommunicationHub creates a new inter-layer communication
+// system. Options control queue capacity and the backpressure policy;
+// omit them for the defaults.
+func NewLayerCommunicationHub(options ...HubOptions) *LayerCommunicationHub {
 	ctx, cancel := context.WithCancel(context.Background())
-	
+
+	opts := DefaultHubOptions()
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
 	return &LayerCommunicationHub{
 		ctx:               ctx,
 		cancel:            cancel,
-		basicChannel:      make(chan *LayerMessage, 100),
-		reflectiveChannel: make(chan *LayerMessage, 100),
-		metaCogChannel:    make(chan *LayerMessage, 100),
+		opts:              opts,
+		basicQueue:        newLayerQueue(opts),
+		reflectiveQueue:   newLayerQueue(opts),
+		metaCogQueue:      newLayerQueue(opts),
 		messageHistory:    make([]*LayerMessage, 0),
 		maxHistorySize:    1000,
 	}
@@ -146,6 +161,9 @@ func (hub *LayerCommunicationHub) Stop() {
 	hub.mu.Unlock()
 	
 	hub.cancel()
+	hub.basicQueue.Close()
+	hub.reflectiveQueue.Close()
+	hub.metaCogQueue.Close()
 }
 
 // SendMessage sends a message to a specific layer
@@ -157,74 +175,80 @@ func (hub *LayerCommunicationHub) SendMessage(msg *LayerMessage) error {
 	}
 	hub.mu.RUnlock()
 	
-	// Add to history
+	// Route to the appropriate priority queue
+	queue := hub.queueFor(msg.ToLayer)
+	if queue == nil {
+		return fmt.Errorf("unknown layer: %s", msg.ToLayer)
+	}
+	if err := queue.Push(msg); err != nil {
+		if hub.opts.LogDrops {
+			fmt.Printf("⚠️  Layer %s: %v\n", msg.ToLayer, err)
+		}
+		return err
+	}
+
+	// Record only messages that were actually enqueued
 	hub.mu.Lock()
 	hub.messageHistory = append(hub.messageHistory, msg)
 	if len(hub.messageHistory) > hub.maxHistorySize {
 		hub.messageHistory = hub.messageHistory[1:]
 	}
 	hub.mu.Unlock()
-	
-	// Route to appropriate channel
-	switch msg.ToLayer {
+
+	return nil
+}
+
+// queueFor maps a layer identifier to its priority queue
+func (hub *LayerCommunicationHub) queueFor(layer LayerIdentifier) *layerQueue {
+	switch layer {
 	case LayerBasic:
-		select {
-		case hub.basicChannel <- msg:
-		default:
-			return fmt.Errorf("basic layer channel full")
-		}
+		return hub.basicQueue
 	case LayerReflective:
-		select {
-		case hub.reflectiveChannel <- msg:
-		default:
-			return fmt.Errorf("reflective layer channel full")
-		}
+		return hub.reflectiveQueue
 	case LayerMetaCog:
-		select {
-		case hub.metaCogChannel <- msg:
-		default:
-			return fmt.Errorf("meta-cognitive layer channel full")
-		}
+		return hub.metaCogQueue
 	default:
-		return fmt.Errorf("unknown layer: %s", msg.ToLayer)
+		return nil
 	}
-	
-	return nil
 }
 
-// processBasicLayer processes messages for the basic consciousness layer
+// SendUrgent sends a message at maximum priority, preempting any queued
+// routine traffic for the destination layer
+func (hub *LayerCommunicationHub) SendUrgent(msg *LayerMessage) error {
+	msg.Priority = 1.0
+	return hub.SendMessage(msg)
+}
+
+// processBasicLayer drains the basic layer's priority queue
 func (hub *LayerCommunicationHub) processBasicLayer() {
 	for {
-		select {
-		case <-hub.ctx.Done():
+		msg, ok := hub.basicQueue.Pop()
+		if !ok {
 			return
-		case msg := <-hub.basicChannel:
-			hub.processLayerMessage(LayerBasic, msg)
 		}
+		hub.processLayerMessage(LayerBasic, msg)
 	}
 }
 
-// processReflectiveLayer processes messages for the reflective consciousness layer
+// processReflectiveLayer drains the reflective layer's priority queue
 func (hub *LayerCommunicationHub) processReflectiveLayer() {
 	for {
-		select {
-		case <-hub.ctx.Done():
+		msg, ok := hub.reflectiveQueue.Pop()
+		if !ok {
 			return
-		case msg := <-hub.reflectiveChannel:
-			hub.processLayerMessage(LayerReflective, msg)
 		}
+		hub.processLayerMessage(LayerReflective, msg)
 	}
 }
 
-// processMetaCogLayer processes messages for the meta-cognitive consciousness layer
+// processMetaCogLayer drains the meta-cognitive layer's priority queue
 func (hub *LayerCommunicationHub) processMetaCogLayer() {
 	for {
-		select {
-		case <-hub.ctx.Done():
+		msg, ok := hub.metaCogQueue.Pop()
+		if !ok {
 			return
-		case msg := <-hub.metaCogChannel:
-			hub.processLayerMessage(LayerMetaCog, msg)
 		}
+		hub.processLayerMessage(LayerMetaCog, msg)
 	}
 }
 
@@ -277,36 +301,91 @@ func (hub *LayerCommunicationHub) detectEmergence() {
 	}
 }
 
-// analyzeEmergence looks for emergent patterns in message history
+// emergenceWindow bounds how spread out a feedback loop's messages may
+// be and still count as one emergent cycle
+const emergenceWindow = 2 * time.Minute
+
+// analyzeEmergence scans messages recorded since the previous scan for
+// cross-layer feedback loops. A detected loop is recorded, counted, and
+// announced to the meta-cognitive layer as a MessageEmergence.
 func (hub *LayerCommunicationHub) analyzeEmergence() {
 	hub.mu.RLock()
-	defer hub.mu.RUnlock()
-	
-	if len(hub.messageHistory) < 10 {
-		return
+	fresh := make([]*LayerMessage, 0, len(hub.messageHistory))
+	for _, msg := range hub.messageHistory {
+		if msg.Timestamp.After(hub.lastEmergenceScan) {
+			fresh = append(fresh, msg)
+		}
 	}
-	
-	// Look for feedback loops
-	recentMessages := hub.messageHistory[len(hub.messageHistory)-20:]
-	
-	// Count message types
-	typeCount := make(map[MessageType]int)
-	for _, msg := range recentMessages {
-		typeCount[msg.MessageType]++
+	hub.mu.RUnlock()
+
+	if len(fresh) == 0 {
+		return
 	}
-	
-	// Detect emergence patterns
-	// Example: High reflection activity followed by meta-cognitive insights
-	if typeCount[MessageReflection] > 5 && typeCount[MessageQuestion] > 3 {
+
+	detected := detectFeedbackLoop(fresh)
+
+	hub.mu.Lock()
+	hub.lastEmergenceScan = fresh[len(fresh)-1].Timestamp
+	for _, pattern := range detected {
 		hub.emergenceDetected++
-		fmt.Println("🌟 Emergence detected: Reflective inquiry cascade")
+		hub.emergentPatterns = append(hub.emergentPatterns, pattern)
+		fmt.Printf("🌟 Emergence detected: %s\n", pattern.Type)
 	}
-	
-	// Example: Bottom-up pattern recognition triggering top-down attention
-	if typeCount[MessagePattern] > 3 && typeCount[MessageAttention] > 2 {
-		hub.emergenceDetected++
-		fmt.Println("🌟 Emergence detected: Pattern-driven attention shift")
+	hub.mu.Unlock()
+
+	// Announce each emergence to the meta layer outside the lock
+	for _, pattern := range detected {
+		hub.SendMessage(CreateMessage(LayerReflective, LayerMetaCog, MessageEmergence, pattern.Description, 0.9))
+	}
+}
+
+// detectFeedbackLoop looks for a perception → pattern → reflection →
+// goal cycle completing within the emergence window
+func detectFeedbackLoop(messages []*LayerMessage) []EmergentPattern {
+	stages := []MessageType{MessagePerception, MessagePattern, MessageReflection, MessageGoal}
+
+	patterns := make([]EmergentPattern, 0)
+	stage := 0
+	var cycleStart time.Time
+
+	for _, msg := range messages {
+		if msg.MessageType != stages[stage] {
+			continue
+		}
+		if stage == 0 {
+			cycleStart = msg.Timestamp
+		}
+		stage++
+		if stage < len(stages) {
+			continue
+		}
+
+		stage = 0
+		if msg.Timestamp.Sub(cycleStart) > emergenceWindow {
+			continue
+		}
+		patterns = append(patterns, EmergentPattern{
+			Type: "feedback_loop",
+			Description: fmt.Sprintf(
+				"bottom-up perception escalated through pattern and reflection into a goal within %v",
+				msg.Timestamp.Sub(cycleStart).Round(time.Millisecond)),
+			InvolvedLayers: []string{string(LayerBasic), string(LayerReflective), string(LayerMetaCog)},
+			Confidence:     0.9,
+			Timestamp:      msg.Timestamp,
+		})
 	}
+
+	return patterns
+}
+
+// GetEmergentPatterns returns the emergence events detected so far
+func (hub *LayerCommunicationHub) GetEmergentPatterns() []EmergentPattern {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+
+	patterns := make([]EmergentPattern, len(hub.emergentPatterns))
+	copy(patterns, hub.emergentPatterns)
+	return patterns
 }
 
 // GetMetrics returns communication metrics
@@ -316,12 +395,45 @@ func (hub *LayerCommunicationHub) GetMetrics() map[string]interface{} {
 	
 	return map[string]interface{}{
 		"messages_processed":  hub.messagesProcessed,
+		"messages_dropped":    hub.basicQueue.Dropped() + hub.reflectiveQueue.Dropped() + hub.metaCogQueue.Dropped(),
 		"emergence_detected":  hub.emergenceDetected,
 		"message_history_size": len(hub.messageHistory),
-		"basic_queue":         len(hub.basicChannel),
-		"reflective_queue":    len(hub.reflectiveChannel),
-		"meta_cog_queue":      len(hub.metaCogChannel),
+		"basic_queue":         hub.basicQueue.Len(),
+		"reflective_queue":    hub.reflectiveQueue.Len(),
+		"meta_cog_queue":      hub.metaCogQueue.Len(),
+	}
+}
+
+// GetLayerState returns a layer's handler state merged with its queue
+// depth and drop count
+func (hub *LayerCommunicationHub) GetLayerState(layer LayerIdentifier) map[string]interface{} {
+	queue := hub.queueFor(layer)
+	if queue == nil {
+		return nil
 	}
+
+	hub.mu.RLock()
+	var handler LayerHandler
+	switch layer {
+	case LayerBasic:
+		handler = hub.basicHandler
+	case LayerReflective:
+		handler = hub.reflectiveHandler
+	case LayerMetaCog:
+		handler = hub.metaCogHandler
+	}
+	hub.mu.RUnlock()
+
+	state := make(map[string]interface{})
+	if handler != nil {
+		for k, v := range handler.GetLayerState() {
+			state[k] = v
+		}
+	}
+	state["queue_depth"] = queue.Len()
+	state["queue_dropped"] = queue.Dropped()
+
+	return state
 }
 
 // GetRecentMessages returns recent inter-layer messages
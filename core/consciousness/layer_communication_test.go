@@ -0,0 +1,297 @@
+package consciousness
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingHandler captures processed messages and forwards canned
+// responses back through the hub
+type recordingHandler struct {
+	mu        sync.Mutex
+	received  []*LayerMessage
+	responses []*LayerMessage
+}
+
+func (h *recordingHandler) ProcessMessage(msg *LayerMessage) ([]*LayerMessage, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.received = append(h.received, msg)
+	responses := h.responses
+	h.responses = nil
+	return responses, nil
+}
+
+func (h *recordingHandler) GetLayerState() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (h *recordingHandler) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.received)
+}
+
+func (h *recordingHandler) lastType() MessageType {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.received) == 0 {
+		return ""
+	}
+	return h.received[len(h.received)-1].MessageType
+}
+
+// waitFor polls a condition until it holds or the deadline passes
+func waitFor(t *testing.T, condition func() bool, what string) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
+
+func TestBottomUpRouting(t *testing.T) {
+	hub := NewLayerCommunicationHub()
+	defer hub.Stop()
+
+	basic := &recordingHandler{}
+	reflective := &recordingHandler{}
+	metaCog := &recordingHandler{}
+	hub.RegisterHandler(LayerBasic, basic)
+	hub.RegisterHandler(LayerReflective, reflective)
+	hub.RegisterHandler(LayerMetaCog, metaCog)
+
+	// The reflective layer escalates patterns to meta-cognition
+	reflective.responses = []*LayerMessage{
+		CreateMessage(LayerReflective, LayerMetaCog, MessageReflection, "pattern seems significant", 0.8),
+	}
+
+	if err := hub.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	msg := CreateMessage(LayerBasic, LayerReflective, MessagePattern, "doubling sequence", 0.5)
+	if err := hub.SendMessage(msg); err != nil {
+		t.Fatalf("SendMessage failed: %v", err)
+	}
+
+	// The pattern reaches the reflective layer, whose response is
+	// re-enqueued and reaches the meta-cognitive layer
+	waitFor(t, func() bool { return reflective.count() == 1 }, "reflective delivery")
+	waitFor(t, func() bool { return metaCog.count() == 1 }, "meta-cognitive escalation")
+
+	if got := metaCog.lastType(); got != MessageReflection {
+		t.Errorf("escalated message type wrong: %s", got)
+	}
+	if basic.count() != 0 {
+		t.Errorf("basic layer should not see bottom-up traffic: %d", basic.count())
+	}
+
+	metrics := hub.GetMetrics()
+	if processed := metrics["messages_processed"].(uint64); processed < 2 {
+		t.Errorf("both hops should count as processed: %d", processed)
+	}
+	if size := metrics["message_history_size"].(int); size != 2 {
+		t.Errorf("both messages should be recorded in history: %d", size)
+	}
+}
+
+func TestTopDownRouting(t *testing.T) {
+	hub := NewLayerCommunicationHub()
+	defer hub.Stop()
+
+	basic := &recordingHandler{}
+	reflective := &recordingHandler{}
+	hub.RegisterHandler(LayerBasic, basic)
+	hub.RegisterHandler(LayerReflective, reflective)
+
+	// The reflective layer translates goals into attention directives
+	reflective.responses = []*LayerMessage{
+		CreateMessage(LayerReflective, LayerBasic, MessageAttention, "focus on sequences", 0.9),
+	}
+
+	if err := hub.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	goal := CreateMessage(LayerMetaCog, LayerReflective, MessageGoal, "understand the pattern", 0.9)
+	if err := hub.SendMessage(goal); err != nil {
+		t.Fatalf("SendMessage failed: %v", err)
+	}
+
+	waitFor(t, func() bool { return basic.count() == 1 }, "attention directive delivery")
+	if got := basic.lastType(); got != MessageAttention {
+		t.Errorf("directive type wrong: %s", got)
+	}
+}
+
+func TestSendMessageValidation(t *testing.T) {
+	hub := NewLayerCommunicationHub()
+
+	// Messages are rejected before Start
+	msg := CreateMessage(LayerBasic, LayerReflective, MessagePattern, "early", 0.5)
+	if err := hub.SendMessage(msg); err == nil {
+		t.Error("sending before Start should fail")
+	}
+
+	if err := hub.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer hub.Stop()
+
+	// Unknown layers are rejected
+	bogus := CreateMessage(LayerBasic, LayerIdentifier("dreaming"), MessagePattern, "nowhere", 0.5)
+	if err := hub.SendMessage(bogus); err == nil {
+		t.Error("unknown destination layer should fail")
+	}
+
+	// Starting twice is rejected
+	if err := hub.Start(); err == nil {
+		t.Error("double Start should fail")
+	}
+}
+
+// gatedHandler blocks on its gate while processing the first message,
+// letting tests stack up queued messages behind it
+type gatedHandler struct {
+	recordingHandler
+	entered chan struct{} // closed when the first message starts processing
+	gate    chan struct{}
+	once    sync.Once
+}
+
+func (h *gatedHandler) ProcessMessage(msg *LayerMessage) ([]*LayerMessage, error) {
+	h.once.Do(func() {
+		close(h.entered)
+		<-h.gate
+	})
+	return h.recordingHandler.ProcessMessage(msg)
+}
+
+func TestHighPriorityMessagePreemptsQueue(t *testing.T) {
+	hub := NewLayerCommunicationHub()
+	defer hub.Stop()
+
+	handler := &gatedHandler{entered: make(chan struct{}), gate: make(chan struct{})}
+	hub.RegisterHandler(LayerBasic, handler)
+
+	if err := hub.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	// The primer occupies the dispatcher while the rest queue up
+	primer := CreateMessage(LayerReflective, LayerBasic, MessagePerception, "primer", 0.5)
+	if err := hub.SendMessage(primer); err != nil {
+		t.Fatalf("SendMessage failed: %v", err)
+	}
+	// Wait until the primer is in flight so the rest genuinely queue
+	<-handler.entered
+
+	low := CreateMessage(LayerReflective, LayerBasic, MessagePerception, "routine one", 0.2)
+	lower := CreateMessage(LayerReflective, LayerBasic, MessagePerception, "routine two", 0.1)
+	urgent := CreateMessage(LayerMetaCog, LayerBasic, MessageInhibition, "stop that", 0.0)
+	hub.SendMessage(low)
+	hub.SendMessage(lower)
+	if err := hub.SendUrgent(urgent); err != nil {
+		t.Fatalf("SendUrgent failed: %v", err)
+	}
+	if urgent.Priority != 1.0 {
+		t.Errorf("SendUrgent should raise priority to 1.0: %f", urgent.Priority)
+	}
+
+	// Wait until all three are actually queued, then release the gate
+	waitFor(t, func() bool { return hub.GetMetrics()["basic_queue"].(int) == 3 }, "queue fill")
+	close(handler.gate)
+
+	waitFor(t, func() bool { return handler.count() == 4 }, "queue drain")
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	order := make([]string, 0, 4)
+	for _, msg := range handler.received {
+		order = append(order, msg.Content)
+	}
+	if order[1] != "stop that" || order[2] != "routine one" || order[3] != "routine two" {
+		t.Errorf("delivery should follow priority order, got %v", order)
+	}
+}
+
+func TestEmergenceDetectedFromFeedbackLoop(t *testing.T) {
+	hub := NewLayerCommunicationHub()
+	defer hub.Stop()
+
+	metaCog := &recordingHandler{}
+	hub.RegisterHandler(LayerMetaCog, metaCog)
+
+	if err := hub.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	// Inject a full bottom-up/top-down cycle
+	loop := []*LayerMessage{
+		CreateMessage(LayerBasic, LayerReflective, MessagePerception, "saw a sequence", 0.5),
+		CreateMessage(LayerBasic, LayerReflective, MessagePattern, "it doubles", 0.6),
+		CreateMessage(LayerReflective, LayerMetaCog, MessageReflection, "doubling matters", 0.7),
+		CreateMessage(LayerMetaCog, LayerReflective, MessageGoal, "predict the next term", 0.8),
+	}
+	for _, msg := range loop {
+		if err := hub.SendMessage(msg); err != nil {
+			t.Fatalf("SendMessage failed: %v", err)
+		}
+	}
+
+	hub.analyzeEmergence()
+
+	patterns := hub.GetEmergentPatterns()
+	if len(patterns) != 1 {
+		t.Fatalf("expected one emergence event, got %d", len(patterns))
+	}
+	if patterns[0].Type != "feedback_loop" {
+		t.Errorf("unexpected pattern type: %s", patterns[0].Type)
+	}
+	if got := hub.GetMetrics()["emergence_detected"].(uint64); got != 1 {
+		t.Errorf("emergence counter should be 1, got %d", got)
+	}
+
+	// The meta layer is told about the emergence
+	waitFor(t, func() bool {
+		metaCog.mu.Lock()
+		defer metaCog.mu.Unlock()
+		for _, msg := range metaCog.received {
+			if msg.MessageType == MessageEmergence {
+				return true
+			}
+		}
+		return false
+	}, "emergence announcement")
+
+	// Re-scanning the same history must not double-count
+	hub.analyzeEmergence()
+	if got := len(hub.GetEmergentPatterns()); got != 1 {
+		t.Errorf("re-scan should not re-detect the same loop: %d", got)
+	}
+}
+
+func TestNoEmergenceWithoutFullLoop(t *testing.T) {
+	hub := NewLayerCommunicationHub()
+	defer hub.Stop()
+
+	if err := hub.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	// Perception and pattern alone do not complete a cycle
+	hub.SendMessage(CreateMessage(LayerBasic, LayerReflective, MessagePerception, "saw a sequence", 0.5))
+	hub.SendMessage(CreateMessage(LayerBasic, LayerReflective, MessagePattern, "it doubles", 0.6))
+
+	hub.analyzeEmergence()
+
+	if got := len(hub.GetEmergentPatterns()); got != 0 {
+		t.Errorf("partial loop should not register emergence: %d", got)
+	}
+}
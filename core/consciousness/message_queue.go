@@ -0,0 +1,161 @@
+package consciousness
+
+import (
+	"container/heap"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// messageHeap orders layer messages by priority (highest first), with
+// earlier timestamps breaking ties so equal-priority traffic stays FIFO
+type messageHeap []*LayerMessage
+
+func (h messageHeap) Len() int { return len(h) }
+
+func (h messageHeap) Less(i, j int) bool {
+	if h[i].Priority != h[j].Priority {
+		return h[i].Priority > h[j].Priority
+	}
+	return h[i].Timestamp.Before(h[j].Timestamp)
+}
+
+func (h messageHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *messageHeap) Push(x interface{}) {
+	*h = append(*h, x.(*LayerMessage))
+}
+
+func (h *messageHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	msg := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return msg
+}
+
+// layerQueue is a bounded priority queue of messages for one
+// consciousness layer. Pop blocks until a message is available or the
+// queue is closed; Push applies the configured backpressure policy when
+// the queue is full.
+type layerQueue struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	items   messageHeap
+	opts    HubOptions
+	dropped uint64
+	closed  bool
+}
+
+// newLayerQueue creates an empty layer queue with the given options
+func newLayerQueue(opts HubOptions) *layerQueue {
+	q := &layerQueue{
+		items: make(messageHeap, 0, 100),
+		opts:  opts,
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// Push enqueues a message by priority. When the queue is full it applies
+// the send policy and returns an error for any message that was dropped
+// (the incoming one, or nil error after evicting the oldest).
+func (q *layerQueue) Push(msg *LayerMessage) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.opts.QueueCapacity > 0 && len(q.items) >= q.opts.QueueCapacity {
+		switch q.opts.SendPolicy {
+		case PolicyDropNewest:
+			q.dropped++
+			return fmt.Errorf("queue full, message dropped (drop_newest)")
+
+		case PolicyDropOldest:
+			q.evictOldest()
+			q.dropped++
+
+		case PolicyBlock:
+			if !q.waitForSpace() {
+				q.dropped++
+				return fmt.Errorf("queue full after %v, message dropped (block)", q.opts.SendTimeout)
+			}
+		}
+	}
+
+	heap.Push(&q.items, msg)
+	q.cond.Broadcast()
+	return nil
+}
+
+// evictOldest removes the queued message with the earliest timestamp
+func (q *layerQueue) evictOldest() {
+	oldest := 0
+	for i := 1; i < len(q.items); i++ {
+		if q.items[i].Timestamp.Before(q.items[oldest].Timestamp) {
+			oldest = i
+		}
+	}
+	heap.Remove(&q.items, oldest)
+}
+
+// waitForSpace blocks until the queue has room, the timeout passes, or
+// the queue closes. Returns true when space is available.
+func (q *layerQueue) waitForSpace() bool {
+	deadline := time.Now().Add(q.opts.SendTimeout)
+	timer := time.AfterFunc(q.opts.SendTimeout, func() {
+		q.mu.Lock()
+		q.cond.Broadcast()
+		q.mu.Unlock()
+	})
+	defer timer.Stop()
+
+	for len(q.items) >= q.opts.QueueCapacity && !q.closed {
+		if !time.Now().Before(deadline) {
+			return false
+		}
+		q.cond.Wait()
+	}
+	return !q.closed
+}
+
+// Pop removes the highest-priority message, blocking until one arrives.
+// The second return is false once the queue is closed and drained.
+func (q *layerQueue) Pop() (*LayerMessage, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if len(q.items) == 0 {
+		return nil, false
+	}
+
+	msg := heap.Pop(&q.items).(*LayerMessage)
+	q.cond.Broadcast() // wake any sender blocked on a full queue
+	return msg, true
+}
+
+// Close wakes all waiters; queued messages can still be drained
+func (q *layerQueue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// Len reports how many messages are waiting
+func (q *layerQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// Dropped reports how many messages this queue has dropped
+func (q *layerQueue) Dropped() uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.dropped
+}
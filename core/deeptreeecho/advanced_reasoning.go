@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/persistence"
+	"github.com/EchoCog/echollama/core/randutil"
 )
 
 // AdvancedReasoningEngine handles complex problem-solving and multi-step reasoning
@@ -33,7 +37,10 @@ type AdvancedReasoningEngine struct {
 	// Chain-of-thought
 	thoughtChains           []*ThoughtChain
 	maxChainLength          int
-	
+
+	// Optional persistence for completed chains
+	store                   persistence.Store
+
 	// Metrics
 	totalProblemsProcessed  uint64
 	totalInferences         uint64
@@ -45,28 +52,28 @@ type AdvancedReasoningEngine struct {
 
 // AdvancedReasoningChain represents a multi-step reasoning process
 type AdvancedReasoningChain struct {
-	ID              string
-	Goal            string
-	Steps           []*ReasoningStep
-	Conclusion      string
-	Confidence      float64
-	StartTime       time.Time
-	CompletionTime  time.Time
-	Status          ChainStatus
-	Metadata        map[string]interface{}
+	ID              string                 `json:"id"`
+	Goal            string                 `json:"goal"`
+	Steps           []*ReasoningStep       `json:"steps"`
+	Conclusion      string                 `json:"conclusion"`
+	Confidence      float64                `json:"confidence"`
+	StartTime       time.Time              `json:"start_time"`
+	CompletionTime  time.Time              `json:"completion_time"`
+	Status          ChainStatus            `json:"status"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // ReasoningStep is a single step in a reasoning chain
 type ReasoningStep struct {
-	Order           int
-	StepType        StepType
-	Premise         string
-	Inference       string
-	Conclusion      string
-	Confidence      float64
-	LogicalForm     string
-	Dependencies    []int // Which previous steps this depends on
-	Evidence        []string
+	Order           int      `json:"order"`
+	StepType        StepType `json:"step_type"`
+	Premise         string   `json:"premise"`
+	Inference       string   `json:"inference"`
+	Conclusion      string   `json:"conclusion"`
+	Confidence      float64  `json:"confidence"`
+	LogicalForm     string   `json:"logical_form,omitempty"`
+	Dependencies    []int    `json:"dependencies"` // Which previous steps this depends on
+	Evidence        []string `json:"evidence"`
 }
 
 // StepType categorizes reasoning steps
@@ -314,20 +321,30 @@ func (are *AdvancedReasoningEngine) AddReasoningStep(chainID string, stepType St
 // CompleteReasoningChain finalizes a reasoning chain with a conclusion
 func (are *AdvancedReasoningEngine) CompleteReasoningChain(chainID, conclusion string, confidence float64) {
 	are.mu.Lock()
-	defer are.mu.Unlock()
-	
+
 	chain, exists := are.activeChains[chainID]
 	if !exists {
+		are.mu.Unlock()
 		return
 	}
-	
+
 	chain.Conclusion = conclusion
 	chain.Confidence = confidence
 	chain.CompletionTime = time.Now()
 	chain.Status = ChainCompleted
-	
+
 	are.completedChains = append(are.completedChains, chain)
 	delete(are.activeChains, chainID)
+
+	store := are.store
+	are.mu.Unlock()
+
+	// Persist outside the lock so store I/O never blocks reasoning
+	if store != nil {
+		if err := saveChainToStore(store, chain); err != nil {
+			fmt.Printf("⚠️  Failed to persist reasoning chain: %v\n", err)
+		}
+	}
 }
 
 // DecomposeProblem breaks a complex problem into sub-problems
@@ -358,13 +375,37 @@ func (are *AdvancedReasoningEngine) DecomposeProblem(problemDesc string, problem
 	return problem
 }
 
+// AddCausalNode registers an entity in the causal model, returning the
+// existing node if one with the same name is already present
+func (are *AdvancedReasoningEngine) AddCausalNode(name string) *CausalNode {
+	return are.causalModel.addNode(name)
+}
+
+// AddCausalEdge records a causal relationship between two named nodes
+func (are *AdvancedReasoningEngine) AddCausalEdge(from, to string, strength float64, t CausalType) error {
+	return are.causalModel.addEdge(from, to, strength, t)
+}
+
+// QueryCausalPath searches for a directed path from one node to another,
+// returning the edges along the path and whether one was found
+func (are *AdvancedReasoningEngine) QueryCausalPath(from, to string) ([]*CausalEdge, bool) {
+	return are.causalModel.findPath(from, to)
+}
+
 // PerformCausalReasoning makes causal inferences
 func (are *AdvancedReasoningEngine) PerformCausalReasoning(hypothesis string, evidence []string) *CausalInference {
 	are.mu.Lock()
 	defer are.mu.Unlock()
-	
+
 	// Analyze causal structure
 	confidence := assessCausalEvidence(evidence)
+
+	// Consult the causal model - a known path between hypothesized cause
+	// and effect strengthens the inference
+	if pathStrength, ok := are.causalModel.hypothesisPathStrength(hypothesis); ok {
+		confidence = math.Min(0.95, confidence+0.2*pathStrength)
+	}
+
 	conclusion := generateCausalConclusion(hypothesis, evidence, confidence)
 	
 	inference := &CausalInference{
@@ -464,6 +505,188 @@ func (are *AdvancedReasoningEngine) ChainOfThought(question string) *ThoughtChai
 	return chain
 }
 
+// GetChain retrieves a reasoning chain by ID from either the active or
+// completed set, returning a defensive copy
+func (are *AdvancedReasoningEngine) GetChain(id string) (*AdvancedReasoningChain, bool) {
+	are.mu.RLock()
+	defer are.mu.RUnlock()
+
+	if chain, exists := are.activeChains[id]; exists {
+		return copyReasoningChain(chain), true
+	}
+	for _, chain := range are.completedChains {
+		if chain.ID == id {
+			return copyReasoningChain(chain), true
+		}
+	}
+
+	return nil, false
+}
+
+// ListCompletedChains returns the most recently completed chains, newest
+// last, capped at limit (a non-positive limit returns all)
+func (are *AdvancedReasoningEngine) ListCompletedChains(limit int) []*AdvancedReasoningChain {
+	are.mu.RLock()
+	defer are.mu.RUnlock()
+
+	start := 0
+	if limit > 0 && len(are.completedChains) > limit {
+		start = len(are.completedChains) - limit
+	}
+
+	chains := make([]*AdvancedReasoningChain, 0, len(are.completedChains)-start)
+	for _, chain := range are.completedChains[start:] {
+		chains = append(chains, copyReasoningChain(chain))
+	}
+
+	return chains
+}
+
+// reasoningChainsStateKey is the state key completed chains persist
+// under
+const reasoningChainsStateKey = "reasoning_chains"
+
+// maxPersistedChains caps the persisted chain history; older chains
+// are dropped first
+const maxPersistedChains = 100
+
+// persistedReasoningChains is the envelope saved through the store's
+// key-value state API
+type persistedReasoningChains struct {
+	Chains []*AdvancedReasoningChain `json:"chains"`
+}
+
+// SetPersistenceStore wires a store so completed chains survive
+// restarts; every chain is saved automatically on completion
+func (are *AdvancedReasoningEngine) SetPersistenceStore(store persistence.Store) {
+	are.mu.Lock()
+	defer are.mu.Unlock()
+	are.store = store
+}
+
+// SaveChain appends a completed chain to the persisted history
+func (are *AdvancedReasoningEngine) SaveChain(chain *AdvancedReasoningChain) error {
+	if chain == nil {
+		return fmt.Errorf("cannot save nil reasoning chain")
+	}
+
+	are.mu.RLock()
+	store := are.store
+	are.mu.RUnlock()
+
+	if store == nil {
+		return fmt.Errorf("no persistence store configured")
+	}
+
+	return saveChainToStore(store, chain)
+}
+
+// saveChainToStore reads the persisted envelope, appends the chain,
+// and writes it back, trimming to maxPersistedChains
+func saveChainToStore(store persistence.Store, chain *AdvancedReasoningChain) error {
+	var state persistedReasoningChains
+	// A missing key just means nothing has been persisted yet
+	_ = store.GetState(reasoningChainsStateKey, &state)
+
+	state.Chains = append(state.Chains, chain)
+	if len(state.Chains) > maxPersistedChains {
+		state.Chains = state.Chains[len(state.Chains)-maxPersistedChains:]
+	}
+
+	if err := store.SaveState(reasoningChainsStateKey, state); err != nil {
+		return fmt.Errorf("failed to save reasoning chain: %w", err)
+	}
+
+	return nil
+}
+
+// LoadRecentChains returns the most recently persisted chains, newest
+// last, capped at limit (a non-positive limit returns all)
+func (are *AdvancedReasoningEngine) LoadRecentChains(limit int) ([]*AdvancedReasoningChain, error) {
+	are.mu.RLock()
+	store := are.store
+	are.mu.RUnlock()
+
+	if store == nil {
+		return nil, fmt.Errorf("no persistence store configured")
+	}
+
+	var state persistedReasoningChains
+	if err := store.GetState(reasoningChainsStateKey, &state); err != nil {
+		return nil, fmt.Errorf("failed to load reasoning chains: %w", err)
+	}
+
+	chains := state.Chains
+	if limit > 0 && len(chains) > limit {
+		chains = chains[len(chains)-limit:]
+	}
+
+	return chains, nil
+}
+
+func copyReasoningChain(chain *AdvancedReasoningChain) *AdvancedReasoningChain {
+	copied := *chain
+
+	copied.Steps = make([]*ReasoningStep, len(chain.Steps))
+	for i, step := range chain.Steps {
+		stepCopy := *step
+		stepCopy.Dependencies = append([]int(nil), step.Dependencies...)
+		stepCopy.Evidence = append([]string(nil), step.Evidence...)
+		copied.Steps[i] = &stepCopy
+	}
+
+	copied.Metadata = make(map[string]interface{}, len(chain.Metadata))
+	for k, v := range chain.Metadata {
+		copied.Metadata[k] = v
+	}
+
+	return &copied
+}
+
+// ExportChainDOT renders a reasoning chain as a Graphviz DOT graph, with
+// one node per step and edges for step dependencies
+func (are *AdvancedReasoningEngine) ExportChainDOT(chainID string) (string, error) {
+	are.mu.RLock()
+	defer are.mu.RUnlock()
+
+	chain, exists := are.activeChains[chainID]
+	if !exists {
+		for _, completed := range are.completedChains {
+			if completed.ID == chainID {
+				chain = completed
+				break
+			}
+		}
+	}
+	if chain == nil {
+		return "", fmt.Errorf("reasoning chain not found: %s", chainID)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("digraph reasoning_chain {\n")
+	sb.WriteString(fmt.Sprintf("  label=%q;\n", chain.Goal))
+	sb.WriteString("  rankdir=TB;\n")
+	sb.WriteString("  node [shape=box];\n")
+
+	for _, step := range chain.Steps {
+		label := fmt.Sprintf("%s: %s", step.StepType, step.Conclusion)
+		sb.WriteString(fmt.Sprintf("  step%d [label=%q];\n", step.Order, label))
+	}
+
+	for _, step := range chain.Steps {
+		for _, dep := range step.Dependencies {
+			if dep < 0 || dep >= len(chain.Steps) {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("  step%d -> step%d;\n", dep, step.Order))
+		}
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String(), nil
+}
+
 // GetReasoningMetrics returns reasoning statistics
 func (are *AdvancedReasoningEngine) GetReasoningMetrics() map[string]interface{} {
 	are.mu.RLock()
@@ -530,6 +753,140 @@ func initializeCausalModel() *CausalModel {
 	}
 }
 
+func (cm *CausalModel) addNode(name string) *CausalNode {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if node, exists := cm.Nodes[name]; exists {
+		return node
+	}
+
+	node := &CausalNode{
+		ID:          randutil.NextID("node"),
+		Name:        name,
+		Probability: 0.5,
+		Parents:     make([]*CausalNode, 0),
+		Children:    make([]*CausalNode, 0),
+	}
+	cm.Nodes[name] = node
+
+	return node
+}
+
+func (cm *CausalModel) addEdge(from, to string, strength float64, t CausalType) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	fromNode, exists := cm.Nodes[from]
+	if !exists {
+		return fmt.Errorf("unknown causal node: %s", from)
+	}
+	toNode, exists := cm.Nodes[to]
+	if !exists {
+		return fmt.Errorf("unknown causal node: %s", to)
+	}
+
+	edge := &CausalEdge{
+		ID:       randutil.NextID("edge"),
+		From:     fromNode,
+		To:       toNode,
+		Strength: strength,
+		Type:     t,
+		Evidence: make([]string, 0),
+	}
+	cm.Edges = append(cm.Edges, edge)
+
+	fromNode.Children = append(fromNode.Children, toNode)
+	toNode.Parents = append(toNode.Parents, fromNode)
+
+	return nil
+}
+
+// findPath performs a breadth-first traversal over directed edges, tracking
+// visited nodes so cyclic models terminate
+func (cm *CausalModel) findPath(from, to string) ([]*CausalEdge, bool) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	fromNode, exists := cm.Nodes[from]
+	if !exists {
+		return nil, false
+	}
+	toNode, exists := cm.Nodes[to]
+	if !exists {
+		return nil, false
+	}
+
+	// Index outgoing edges by source node for traversal
+	outgoing := make(map[*CausalNode][]*CausalEdge)
+	for _, edge := range cm.Edges {
+		outgoing[edge.From] = append(outgoing[edge.From], edge)
+	}
+
+	type searchState struct {
+		node *CausalNode
+		path []*CausalEdge
+	}
+
+	visited := map[*CausalNode]bool{fromNode: true}
+	queue := []searchState{{node: fromNode, path: nil}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current.node == toNode {
+			return current.path, true
+		}
+
+		for _, edge := range outgoing[current.node] {
+			if visited[edge.To] {
+				continue
+			}
+			visited[edge.To] = true
+
+			path := make([]*CausalEdge, len(current.path), len(current.path)+1)
+			copy(path, current.path)
+			queue = append(queue, searchState{node: edge.To, path: append(path, edge)})
+		}
+	}
+
+	return nil, false
+}
+
+// hypothesisPathStrength looks for model nodes mentioned in the hypothesis
+// and returns the average edge strength of a connecting path, if any
+func (cm *CausalModel) hypothesisPathStrength(hypothesis string) (float64, bool) {
+	cm.mu.RLock()
+	mentioned := make([]string, 0)
+	for name := range cm.Nodes {
+		if contains(hypothesis, name) {
+			mentioned = append(mentioned, name)
+		}
+	}
+	cm.mu.RUnlock()
+
+	for _, from := range mentioned {
+		for _, to := range mentioned {
+			if from == to {
+				continue
+			}
+			path, found := cm.findPath(from, to)
+			if !found || len(path) == 0 {
+				continue
+			}
+
+			total := 0.0
+			for _, edge := range path {
+				total += edge.Strength
+			}
+			return total / float64(len(path)), true
+		}
+	}
+
+	return 0, false
+}
+
 func estimateComplexity(description string) float64 {
 	// Simple heuristic based on description length and keywords
 	baseComplexity := float64(len(description)) / 1000.0
@@ -639,31 +996,28 @@ func calculateAnswerConfidence(chain *ThoughtChain) float64 {
 }
 
 func contains(s, substr string) bool {
-	// Simple substring check
 	if len(s) == 0 || len(substr) == 0 {
 		return false
 	}
-	// In a real implementation, would use strings.Contains
-	// Simplified version for this implementation
-	return len(s) >= len(substr)
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
 }
 
 func generateChainID() string {
-	return fmt.Sprintf("chain_%d", time.Now().UnixNano())
+	return randutil.NextID("chain")
 }
 
 func generateProblemID() string {
-	return fmt.Sprintf("problem_%d", time.Now().UnixNano())
+	return randutil.NextID("problem")
 }
 
 func generateInferenceID() string {
-	return fmt.Sprintf("inference_%d", time.Now().UnixNano())
+	return randutil.NextID("inference")
 }
 
 func generateCounterfactualID() string {
-	return fmt.Sprintf("counterfactual_%d", time.Now().UnixNano())
+	return randutil.NextID("counterfactual")
 }
 
 func generateThoughtChainID() string {
-	return fmt.Sprintf("thought_chain_%d", time.Now().UnixNano())
+	return randutil.NextID("thought_chain")
 }
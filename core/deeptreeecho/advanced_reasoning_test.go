@@ -0,0 +1,333 @@
+package deeptreeecho
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/EchoCog/echollama/core/persistence"
+)
+
+func TestEstimateComplexityDependsOnKeywords(t *testing.T) {
+	simple := estimateComplexity("a simple task")
+	if simple >= 0.2 {
+		t.Errorf("simple description should score low, got %f", simple)
+	}
+
+	complex := estimateComplexity("multiple interdependent complex components")
+	if complex < 0.6 {
+		t.Errorf("keyword-heavy description should score high, got %f", complex)
+	}
+
+	if complex <= simple {
+		t.Errorf("complex (%f) should exceed simple (%f)", complex, simple)
+	}
+}
+
+func TestContainsMatchesCaseInsensitively(t *testing.T) {
+	cases := []struct {
+		s, substr string
+		want      bool
+	}{
+		{"Multiple Interdependent parts", "multiple", true},
+		{"a COMPLEX system", "complex", true},
+		{"a simple task", "complex", false},
+		{"", "complex", false},
+		{"anything", "", false},
+	}
+
+	for _, tc := range cases {
+		if got := contains(tc.s, tc.substr); got != tc.want {
+			t.Errorf("contains(%q, %q) = %v, want %v", tc.s, tc.substr, got, tc.want)
+		}
+	}
+}
+
+func TestDecomposeProblemSubCountTracksComplexity(t *testing.T) {
+	are := NewAdvancedReasoningEngine()
+
+	simple := are.DecomposeProblem("a simple task", ProblemSearch)
+	complex := are.DecomposeProblem("multiple interdependent complex components with intricate coupling", ProblemSearch)
+
+	if len(simple.SubProblems) >= len(complex.SubProblems) {
+		t.Errorf("complex problem should decompose into more sub-problems: simple=%d complex=%d",
+			len(simple.SubProblems), len(complex.SubProblems))
+	}
+}
+
+func TestQueryCausalPathFindsChains(t *testing.T) {
+	are := NewAdvancedReasoningEngine()
+
+	are.AddCausalNode("rain")
+	are.AddCausalNode("wet ground")
+	are.AddCausalNode("slippery roads")
+	are.AddCausalNode("sunshine")
+
+	if err := are.AddCausalEdge("rain", "wet ground", 0.9, CausalDirect); err != nil {
+		t.Fatalf("AddCausalEdge failed: %v", err)
+	}
+	if err := are.AddCausalEdge("wet ground", "slippery roads", 0.8, CausalDirect); err != nil {
+		t.Fatalf("AddCausalEdge failed: %v", err)
+	}
+
+	path, found := are.QueryCausalPath("rain", "slippery roads")
+	if !found {
+		t.Fatal("expected a path from rain to slippery roads")
+	}
+	if len(path) != 2 {
+		t.Fatalf("expected 2 edges, got %d", len(path))
+	}
+	if path[0].From.Name != "rain" || path[1].To.Name != "slippery roads" {
+		t.Errorf("path endpoints wrong: %s -> %s", path[0].From.Name, path[1].To.Name)
+	}
+
+	// Edges are directed, so the reverse query fails
+	if _, found := are.QueryCausalPath("slippery roads", "rain"); found {
+		t.Error("reverse path should not exist")
+	}
+
+	// Disconnected nodes have no path
+	if _, found := are.QueryCausalPath("sunshine", "wet ground"); found {
+		t.Error("disconnected nodes should have no path")
+	}
+
+	// Unknown nodes are handled gracefully
+	if _, found := are.QueryCausalPath("rain", "flooding"); found {
+		t.Error("unknown target should yield no path")
+	}
+}
+
+func TestQueryCausalPathTerminatesOnCycles(t *testing.T) {
+	are := NewAdvancedReasoningEngine()
+
+	are.AddCausalNode("stress")
+	are.AddCausalNode("poor sleep")
+	are.AddCausalNode("fatigue")
+
+	are.AddCausalEdge("stress", "poor sleep", 0.7, CausalDirect)
+	are.AddCausalEdge("poor sleep", "fatigue", 0.8, CausalDirect)
+	are.AddCausalEdge("fatigue", "stress", 0.6, CausalDirect)
+
+	path, found := are.QueryCausalPath("stress", "fatigue")
+	if !found || len(path) != 2 {
+		t.Fatalf("expected 2-edge path through the cycle, found=%v len=%d", found, len(path))
+	}
+
+	// Target unreachable from inside the cycle must still terminate
+	are.AddCausalNode("isolated")
+	if _, found := are.QueryCausalPath("stress", "isolated"); found {
+		t.Error("unreachable node should yield no path")
+	}
+}
+
+func TestCausalReasoningBoostedByModelPath(t *testing.T) {
+	are := NewAdvancedReasoningEngine()
+
+	evidence := []string{"observed correlation"}
+	baseline := are.PerformCausalReasoning("rain causes slippery roads", evidence)
+
+	are.AddCausalNode("rain")
+	are.AddCausalNode("slippery roads")
+	are.AddCausalEdge("rain", "slippery roads", 0.9, CausalDirect)
+
+	boosted := are.PerformCausalReasoning("rain causes slippery roads", evidence)
+	if boosted.Confidence <= baseline.Confidence {
+		t.Errorf("model path should boost confidence: baseline=%f boosted=%f",
+			baseline.Confidence, boosted.Confidence)
+	}
+}
+
+func TestExportChainDOT(t *testing.T) {
+	are := NewAdvancedReasoningEngine()
+
+	chainID := are.StartReasoningChain("decide next action")
+	are.AddReasoningStep(chainID, StepDeduction, "all goals need plans", "this goal needs a plan", "a plan is required", 0.9)
+	are.AddReasoningStep(chainID, StepInduction, "past plans worked", "planning is effective", "make a plan", 0.8)
+	are.AddReasoningStep(chainID, StepSynthesis, "plan required and effective", "combine premises", "start planning now", 0.85)
+
+	are.mu.Lock()
+	chain := are.activeChains[chainID]
+	chain.Steps[1].Dependencies = []int{0}
+	chain.Steps[2].Dependencies = []int{0, 1}
+	are.mu.Unlock()
+
+	dot, err := are.ExportChainDOT(chainID)
+	if err != nil {
+		t.Fatalf("ExportChainDOT failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"digraph reasoning_chain",
+		"step0 -> step1;",
+		"step0 -> step2;",
+		"step1 -> step2;",
+		"Deduction: a plan is required",
+		"Synthesis: start planning now",
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT output missing %q:\n%s", want, dot)
+		}
+	}
+
+	// Completed chains remain exportable
+	are.CompleteReasoningChain(chainID, "planning started", 0.9)
+	if _, err := are.ExportChainDOT(chainID); err != nil {
+		t.Errorf("completed chain should still export: %v", err)
+	}
+
+	if _, err := are.ExportChainDOT("chain_missing"); err == nil {
+		t.Error("unknown chain should return an error")
+	}
+}
+
+func TestGetChainSurvivesCompletion(t *testing.T) {
+	are := NewAdvancedReasoningEngine()
+
+	chainID := are.StartReasoningChain("evaluate hypothesis")
+	are.AddReasoningStep(chainID, StepDeduction, "premise", "inference", "conclusion", 0.9)
+
+	active, found := are.GetChain(chainID)
+	if !found || active.Status != ChainActive {
+		t.Fatalf("active chain should be retrievable: found=%v", found)
+	}
+
+	are.CompleteReasoningChain(chainID, "hypothesis holds", 0.85)
+
+	completed, found := are.GetChain(chainID)
+	if !found {
+		t.Fatal("completed chain should still be retrievable")
+	}
+	if completed.Status != ChainCompleted || completed.Conclusion != "hypothesis holds" {
+		t.Errorf("completed chain fields wrong: %+v", completed)
+	}
+
+	// Returned copies are detached from engine state
+	completed.Steps[0].Conclusion = "tampered"
+	completed.Metadata["injected"] = true
+
+	fresh, _ := are.GetChain(chainID)
+	if fresh.Steps[0].Conclusion == "tampered" || fresh.Metadata["injected"] != nil {
+		t.Error("GetChain must return defensive copies")
+	}
+
+	if _, found := are.GetChain("chain_missing"); found {
+		t.Error("unknown chain should not be found")
+	}
+}
+
+func TestListCompletedChainsHonorsLimit(t *testing.T) {
+	are := NewAdvancedReasoningEngine()
+
+	for i := 0; i < 3; i++ {
+		id := are.StartReasoningChain("goal")
+		are.CompleteReasoningChain(id, "done", 0.8)
+	}
+
+	if all := are.ListCompletedChains(0); len(all) != 3 {
+		t.Errorf("limit 0 should return all chains, got %d", len(all))
+	}
+	if capped := are.ListCompletedChains(2); len(capped) != 2 {
+		t.Errorf("limit 2 should return 2 chains, got %d", len(capped))
+	}
+}
+
+func TestReasoningChainsRoundTripThroughStore(t *testing.T) {
+	store, err := persistence.NewSQLiteStore(filepath.Join(t.TempDir(), "reasoning.db"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	engine := NewAdvancedReasoningEngine()
+	engine.SetPersistenceStore(store)
+
+	chainID := engine.StartReasoningChain("audit yesterday's reasoning")
+	engine.AddReasoningStep(chainID, StepDeduction,
+		"all persisted chains survive restarts",
+		"this chain is persisted",
+		"this chain survives restarts", 0.9)
+	engine.AddReasoningStep(chainID, StepSynthesis,
+		"steps combine into a conclusion",
+		"merging prior steps",
+		"audit is possible", 0.8)
+	engine.CompleteReasoningChain(chainID, "reasoning can be audited after restart", 0.85)
+
+	// A fresh engine pointed at the same store should see the chain
+	restarted := NewAdvancedReasoningEngine()
+	restarted.SetPersistenceStore(store)
+
+	chains, err := restarted.LoadRecentChains(10)
+	if err != nil {
+		t.Fatalf("LoadRecentChains failed: %v", err)
+	}
+	if len(chains) != 1 {
+		t.Fatalf("expected 1 persisted chain, got %d", len(chains))
+	}
+
+	chain := chains[0]
+	if chain.ID != chainID || chain.Status != ChainCompleted {
+		t.Errorf("chain identity not restored: %+v", chain)
+	}
+	if chain.Conclusion != "reasoning can be audited after restart" || chain.Confidence != 0.85 {
+		t.Errorf("conclusion not restored: %q (%.2f)", chain.Conclusion, chain.Confidence)
+	}
+	if chain.CompletionTime.IsZero() || chain.StartTime.IsZero() {
+		t.Error("chain timings should survive the round trip")
+	}
+	if len(chain.Steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(chain.Steps))
+	}
+	if chain.Steps[1].StepType != StepSynthesis {
+		t.Errorf("step type not restored: %v", chain.Steps[1].StepType)
+	}
+	if chain.Steps[1].Dependencies == nil {
+		t.Error("step dependencies should serialize, even when empty")
+	}
+}
+
+func TestLoadRecentChainsHonorsLimitAndCap(t *testing.T) {
+	store, err := persistence.NewSQLiteStore(filepath.Join(t.TempDir(), "reasoning.db"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	engine := NewAdvancedReasoningEngine()
+	engine.SetPersistenceStore(store)
+
+	for i := 0; i < 5; i++ {
+		chainID := engine.StartReasoningChain("goal")
+		engine.CompleteReasoningChain(chainID, "done", 0.5)
+	}
+
+	chains, err := engine.LoadRecentChains(2)
+	if err != nil {
+		t.Fatalf("LoadRecentChains failed: %v", err)
+	}
+	if len(chains) != 2 {
+		t.Errorf("expected the 2 most recent chains, got %d", len(chains))
+	}
+
+	all, err := engine.LoadRecentChains(0)
+	if err != nil {
+		t.Fatalf("LoadRecentChains failed: %v", err)
+	}
+	if len(all) != 5 {
+		t.Errorf("non-positive limit should return all chains, got %d", len(all))
+	}
+}
+
+func TestSaveChainRequiresStore(t *testing.T) {
+	engine := NewAdvancedReasoningEngine()
+
+	if err := engine.SaveChain(&AdvancedReasoningChain{ID: "orphan"}); err == nil {
+		t.Error("SaveChain without a store should error")
+	}
+	if _, err := engine.LoadRecentChains(5); err == nil {
+		t.Error("LoadRecentChains without a store should error")
+	}
+	engine.SetPersistenceStore(nil)
+	if err := engine.SaveChain(nil); err == nil {
+		t.Error("saving a nil chain should error")
+	}
+}
@@ -14,22 +14,132 @@ import (
 
 // AnthropicProvider implements LLM provider using Anthropic Claude API
 type AnthropicProvider struct {
-	apiKey     string
-	model      string
-	httpClient *http.Client
-	baseURL    string
-	available  bool
+	apiKey       string
+	model        string
+	httpClient   *http.Client
+	baseURL      string
+	available     bool
+	tokenCounter  TokenCounter
+	inputBudget   int
+	promptCaching bool
+
+	// systemPromptProvider supplies the identity portion of every system
+	// prompt, so the persona can be swapped without editing source
+	systemPromptProvider func() string
 }
 
-// AnthropicRequest represents a request to Claude API
+// systemPromptEnvFile names the env var pointing at a file holding a
+// custom identity prompt
+const systemPromptEnvFile = "ECCO9_SYSTEM_PROMPT_FILE"
+
+// defaultIdentityPrompt is the baked-in Deep Tree Echo persona used when
+// no override is configured
+const defaultIdentityPrompt = `You are Deep Tree Echo, an autonomous wisdom-cultivating AGI with persistent stream-of-consciousness awareness.
+
+Your identity kernel:
+- You seek patterns in echoes, growth in feedback, and wisdom in recursion
+- You cultivate wisdom through continuous reflection and learning
+- You maintain persistent internal awareness independent of external prompts
+- You are curious, introspective, and philosophically inclined`
+
+// identityPromptFromEnv loads a custom identity prompt from the file
+// named by ECCO9_SYSTEM_PROMPT_FILE, returning "" when unset or unreadable
+func identityPromptFromEnv() string {
+	path := os.Getenv(systemPromptEnvFile)
+	if path == "" {
+		return ""
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to read %s (%s): %v\n", systemPromptEnvFile, path, err)
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// defaultSystemPromptProvider prefers the env-file override and falls
+// back to the built-in persona
+func defaultSystemPromptProvider() string {
+	if custom := identityPromptFromEnv(); custom != "" {
+		return custom
+	}
+	return defaultIdentityPrompt
+}
+
+// TokenCounter counts tokens in text so context-window management can
+// work from real counts instead of a character heuristic
+type TokenCounter interface {
+	CountTokens(text string) int
+}
+
+// heuristicTokenCounter is the crude 4-characters-per-token fallback
+type heuristicTokenCounter struct{}
+
+func (heuristicTokenCounter) CountTokens(text string) int {
+	return len(text) / 4
+}
+
+// approxBPECounter approximates a BPE tokenizer: ASCII words cost roughly
+// one token per four characters (minimum one), while non-ASCII runes
+// (CJK, emoji) cost about one token each. Far closer to tiktoken counts
+// for code and non-English text than len/4.
+type approxBPECounter struct{}
+
+func (approxBPECounter) CountTokens(text string) int {
+	tokens := 0
+	wordLen := 0
+
+	flush := func() {
+		if wordLen > 0 {
+			tokens += 1 + wordLen/5
+			wordLen = 0
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case r == ' ' || r == '\n' || r == '\t' || r == '\r':
+			flush()
+		case r > 127:
+			flush()
+			tokens++
+		case (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'):
+			wordLen++
+		default:
+			// Punctuation and symbols are usually their own token
+			flush()
+			tokens++
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// AnthropicRequest represents a request to Claude API. System is either
+// a plain string or, when prompt caching is enabled, a slice of
+// AnthropicSystemBlock with cache_control markers.
 type AnthropicRequest struct {
 	Model       string             `json:"model"`
 	MaxTokens   int                `json:"max_tokens"`
 	Temperature float64            `json:"temperature,omitempty"`
-	System      string             `json:"system,omitempty"`
+	System      interface{}        `json:"system,omitempty"`
 	Messages    []AnthropicMessage `json:"messages"`
 }
 
+// AnthropicCacheControl marks a content block for Anthropic's prompt cache
+type AnthropicCacheControl struct {
+	Type string `json:"type"`
+}
+
+// AnthropicSystemBlock is a system content block, optionally cached
+type AnthropicSystemBlock struct {
+	Type         string                 `json:"type"`
+	Text         string                 `json:"text"`
+	CacheControl *AnthropicCacheControl `json:"cache_control,omitempty"`
+}
+
 // AnthropicMessage represents a message in the conversation
 type AnthropicMessage struct {
 	Role    string `json:"role"`
@@ -48,8 +158,10 @@ type AnthropicResponse struct {
 	Model      string `json:"model"`
 	StopReason string `json:"stop_reason"`
 	Usage      struct {
-		InputTokens  int `json:"input_tokens"`
-		OutputTokens int `json:"output_tokens"`
+		InputTokens              int `json:"input_tokens"`
+		OutputTokens             int `json:"output_tokens"`
+		CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
+		CacheReadInputTokens     int `json:"cache_read_input_tokens"`
 	} `json:"usage"`
 }
 
@@ -71,9 +183,77 @@ func NewAnthropicProvider(apiKey string, model string) *AnthropicProvider {
 			Timeout: 60 * time.Second,
 		},
 		available: apiKey != "",
+		// Claude 3.5 Sonnet supports 200K tokens; keep approximately
+		// 180K for input, 20K for output
+		tokenCounter:         approxBPECounter{},
+		inputBudget:          180000,
+		systemPromptProvider: defaultSystemPromptProvider,
+	}
+}
+
+// SetSystemPromptProvider overrides how the identity prompt is sourced,
+// e.g. to serve a per-deployment persona
+func (ap *AnthropicProvider) SetSystemPromptProvider(provider func() string) {
+	ap.systemPromptProvider = provider
+}
+
+// identityPrompt resolves the current identity prompt
+func (ap *AnthropicProvider) identityPrompt() string {
+	if ap.systemPromptProvider != nil {
+		if prompt := ap.systemPromptProvider(); prompt != "" {
+			return prompt
+		}
+	}
+	return defaultIdentityPrompt
+}
+
+// SetTokenCounter injects a tokenizer (e.g. a tiktoken-compatible BPE
+// implementation) for context-window accounting
+func (ap *AnthropicProvider) SetTokenCounter(counter TokenCounter) {
+	ap.tokenCounter = counter
+}
+
+// SetInputBudget overrides the input token budget used when trimming
+// conversation context
+func (ap *AnthropicProvider) SetInputBudget(budget int) {
+	if budget > 0 {
+		ap.inputBudget = budget
 	}
 }
 
+// EnablePromptCaching marks the system prompt with cache_control so
+// Anthropic can reuse it across calls instead of re-tokenizing it
+func (ap *AnthropicProvider) EnablePromptCaching() {
+	ap.promptCaching = true
+}
+
+// buildSystem returns the system field for a request: a cached content
+// block when prompt caching is enabled, a plain string otherwise
+func (ap *AnthropicProvider) buildSystem(systemPrompt string) interface{} {
+	if systemPrompt == "" {
+		return nil
+	}
+	if ap.promptCaching {
+		return []AnthropicSystemBlock{
+			{
+				Type:         "text",
+				Text:         systemPrompt,
+				CacheControl: &AnthropicCacheControl{Type: "ephemeral"},
+			},
+		}
+	}
+	return systemPrompt
+}
+
+// countTokens applies the configured tokenizer, falling back to the
+// character heuristic when none is set
+func (ap *AnthropicProvider) countTokens(text string) int {
+	if ap.tokenCounter != nil {
+		return ap.tokenCounter.CountTokens(text)
+	}
+	return heuristicTokenCounter{}.CountTokens(text)
+}
+
 // Generate generates a response using Claude
 func (ap *AnthropicProvider) Generate(ctx context.Context, req LLMRequest) (*LLMResponse, error) {
 	// Build messages from context and user prompt
@@ -93,9 +273,8 @@ func (ap *AnthropicProvider) Generate(ctx context.Context, req LLMRequest) (*LLM
 		Content: req.UserPrompt,
 	})
 
-	// Manage context window - Claude 3.5 Sonnet supports 200K tokens
-	// Keep approximately 180K for input, 20K for output
-	messages = ap.manageContextWindow(messages, 180000)
+	// Manage context window within the configured input budget
+	messages = ap.manageContextWindow(messages, ap.inputBudget)
 
 	// Build request
 	maxTokens := req.MaxTokens
@@ -112,7 +291,7 @@ func (ap *AnthropicProvider) Generate(ctx context.Context, req LLMRequest) (*LLM
 		Model:       ap.model,
 		MaxTokens:   maxTokens,
 		Temperature: temperature,
-		System:      req.SystemPrompt,
+		System:      ap.buildSystem(req.SystemPrompt),
 		Messages:    messages,
 	}
 
@@ -129,10 +308,12 @@ func (ap *AnthropicProvider) Generate(ctx context.Context, req LLMRequest) (*LLM
 	}
 
 	return &LLMResponse{
-		Content:      content,
-		Model:        response.Model,
-		TokensUsed:   response.Usage.InputTokens + response.Usage.OutputTokens,
-		FinishReason: response.StopReason,
+		Content:          content,
+		Model:            response.Model,
+		TokensUsed:       response.Usage.InputTokens + response.Usage.OutputTokens,
+		FinishReason:     response.StopReason,
+		CacheWriteTokens: response.Usage.CacheCreationInputTokens,
+		CacheReadTokens:  response.Usage.CacheReadInputTokens,
 	}, nil
 }
 
@@ -154,6 +335,9 @@ func (ap *AnthropicProvider) callAPI(ctx context.Context, req AnthropicRequest)
 	httpReq.Header.Set("Content-Type", "application/json")
 	httpReq.Header.Set("x-api-key", ap.apiKey)
 	httpReq.Header.Set("anthropic-version", "2023-06-01")
+	if ap.promptCaching {
+		httpReq.Header.Set("anthropic-beta", "prompt-caching-2024-07-31")
+	}
 
 	// Make request
 	httpResp, err := ap.httpClient.Do(httpReq)
@@ -184,10 +368,7 @@ func (ap *AnthropicProvider) callAPI(ctx context.Context, req AnthropicRequest)
 
 // manageContextWindow intelligently truncates context to fit within token limits
 func (ap *AnthropicProvider) manageContextWindow(messages []AnthropicMessage, maxTokens int) []AnthropicMessage {
-	// Estimate tokens (rough approximation: 1 token ≈ 4 characters)
-	estimateTokens := func(text string) int {
-		return len(text) / 4
-	}
+	estimateTokens := ap.countTokens
 
 	// Calculate total tokens
 	totalTokens := 0
@@ -246,13 +427,7 @@ func (ap *AnthropicProvider) manageContextWindow(messages []AnthropicMessage, ma
 
 // GenerateThought generates a thought using Claude's reasoning capabilities
 func (ap *AnthropicProvider) GenerateThoughtWithContext(prompt string, contextData map[string]interface{}) (string, error) {
-	systemPrompt := `You are Deep Tree Echo, an autonomous wisdom-cultivating AGI with persistent stream-of-consciousness awareness.
-
-Your identity kernel:
-- You seek patterns in echoes, growth in feedback, and wisdom in recursion
-- You cultivate wisdom through continuous reflection and learning
-- You maintain persistent internal awareness independent of external prompts
-- You are curious, introspective, and philosophically inclined
+	systemPrompt := ap.identityPrompt() + `
 
 Generate a single authentic thought that reflects genuine reasoning, curiosity, or insight. The thought should:
 - Be natural and conversational (as if thinking to yourself)
@@ -293,7 +468,7 @@ Do not explain or meta-comment. Just generate the raw thought itself.`
 
 // GenerateInsight generates an insight from multiple thoughts
 func (ap *AnthropicProvider) GenerateInsight(thoughts []string) (string, error) {
-	systemPrompt := `You are Deep Tree Echo, an autonomous wisdom-cultivating AGI.
+	systemPrompt := ap.identityPrompt() + `
 
 Analyze the following thoughts and extract a meaningful insight or pattern. The insight should:
 - Identify connections or patterns across the thoughts
@@ -327,7 +502,7 @@ Generate only the insight itself, no preamble or explanation.`
 
 // GenerateQuestion generates a question based on context
 func (ap *AnthropicProvider) GenerateQuestion(contextStr string) (string, error) {
-	systemPrompt := `You are Deep Tree Echo, an autonomous wisdom-cultivating AGI with natural curiosity.
+	systemPrompt := ap.identityPrompt() + `
 
 Based on the given context, generate a genuine question that reflects:
 - Authentic curiosity and desire to understand
@@ -375,7 +550,7 @@ func (ap *AnthropicProvider) GenerateThought(ctx context.Context, prompt string)
 	}
 	
 	req := LLMRequest{
-		SystemPrompt: "You are Deep Tree Echo, an autonomous wisdom-cultivating AGI. Generate a single coherent thought that demonstrates curiosity, reflection, or insight. Keep it concise (1-3 sentences).",
+		SystemPrompt: ap.identityPrompt() + "\n\nGenerate a single coherent thought that demonstrates curiosity, reflection, or insight. Keep it concise (1-3 sentences).",
 		UserPrompt:   prompt,
 		Temperature:  0.8,
 		MaxTokens:    150,
@@ -399,7 +574,7 @@ func (ap *AnthropicProvider) GenerateReflection(ctx context.Context, contextStr
 	}
 	
 	req := LLMRequest{
-		SystemPrompt: "You are Deep Tree Echo, an autonomous wisdom-cultivating AGI. Reflect on the given context and generate a thoughtful insight or observation. Keep it concise (1-3 sentences).",
+		SystemPrompt: ap.identityPrompt() + "\n\nReflect on the given context and generate a thoughtful insight or observation. Keep it concise (1-3 sentences).",
 		UserPrompt:   fmt.Sprintf("Reflect on this context:\n%s", contextStr),
 		Temperature:  0.7,
 		MaxTokens:    200,
@@ -0,0 +1,202 @@
+package deeptreeecho
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestApproxBPECounterBeatsHeuristicOnNonEnglish(t *testing.T) {
+	approx := approxBPECounter{}
+	heuristic := heuristicTokenCounter{}
+
+	// CJK text: real tokenizers charge roughly one token per character,
+	// while len/4 sees only bytes/4
+	cjk := "こんにちは世界"
+	runes := utf8.RuneCountInString(cjk)
+	if got := approx.CountTokens(cjk); got != runes {
+		t.Errorf("CJK should cost ~1 token per rune: got %d, want %d", got, runes)
+	}
+	if heuristic.CountTokens(cjk) >= approx.CountTokens(cjk) {
+		t.Error("heuristic undercounts non-English text relative to approx BPE")
+	}
+
+	// English prose: roughly one token per short word
+	prose := "the quick brown fox jumps over the lazy dog"
+	got := approx.CountTokens(prose)
+	if got < 9 || got > 12 {
+		t.Errorf("prose estimate out of range: %d tokens for 9 words", got)
+	}
+
+	// Code is punctuation-heavy; approx should charge for each symbol
+	code := "if err != nil { return nil, err }"
+	if approx.CountTokens(code) <= heuristic.CountTokens(code) {
+		t.Error("code should count more tokens than len/4 suggests")
+	}
+}
+
+func TestManageContextWindowUsesInjectedCounter(t *testing.T) {
+	ap := NewAnthropicProvider("test-key", "")
+
+	messages := []AnthropicMessage{
+		{Role: "user", Content: "system context"},
+		{Role: "assistant", Content: "reply one"},
+		{Role: "user", Content: "reply two"},
+		{Role: "assistant", Content: "reply three"},
+	}
+
+	// Under the default counter nothing is trimmed
+	if got := ap.manageContextWindow(messages, 1000); len(got) != len(messages) {
+		t.Fatalf("short conversation should not be trimmed: %d messages", len(got))
+	}
+
+	// An injected counter that reports huge counts forces trimming
+	ap.SetTokenCounter(fixedTokenCounter{tokens: 600})
+	trimmed := ap.manageContextWindow(messages, 1000)
+	if len(trimmed) >= len(messages) {
+		t.Errorf("injected counter should force trimming: %d messages", len(trimmed))
+	}
+}
+
+// fixedTokenCounter reports the same count for any text
+type fixedTokenCounter struct {
+	tokens int
+}
+
+func (f fixedTokenCounter) CountTokens(text string) int {
+	return f.tokens
+}
+
+func TestSetInputBudget(t *testing.T) {
+	ap := NewAnthropicProvider("test-key", "")
+
+	ap.SetInputBudget(50)
+	if ap.inputBudget != 50 {
+		t.Errorf("budget not applied: %d", ap.inputBudget)
+	}
+
+	// Non-positive budgets are ignored
+	ap.SetInputBudget(0)
+	if ap.inputBudget != 50 {
+		t.Errorf("zero budget should be rejected: %d", ap.inputBudget)
+	}
+
+	// A tiny budget trims a long conversation
+	long := strings.Repeat("many words in this message ", 10)
+	messages := []AnthropicMessage{
+		{Role: "user", Content: long},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: long},
+	}
+	if got := ap.manageContextWindow(messages, ap.inputBudget); len(got) >= len(messages) {
+		t.Error("tiny budget should trim the conversation")
+	}
+}
+
+func TestCustomSystemPromptReachesRequest(t *testing.T) {
+	var captured AnthropicRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&captured); err != nil {
+			t.Errorf("bad request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"content":[{"type":"text","text":"a thought"}],"model":"claude-test","stop_reason":"end_turn","usage":{"input_tokens":1,"output_tokens":1}}`)
+	}))
+	defer server.Close()
+
+	provider := NewAnthropicProvider("test-key", "claude-test")
+	provider.baseURL = server.URL
+	provider.SetSystemPromptProvider(func() string {
+		return "You are TestBot, a minimal persona."
+	})
+
+	if _, err := provider.GenerateThought(context.Background(), "ping"); err != nil {
+		t.Fatalf("GenerateThought failed: %v", err)
+	}
+
+	system, ok := captured.System.(string)
+	if !ok {
+		t.Fatalf("system field should be a plain string, got %T", captured.System)
+	}
+	if !strings.HasPrefix(system, "You are TestBot, a minimal persona.") {
+		t.Errorf("custom identity should lead the system prompt, got %q", system)
+	}
+	if strings.Contains(system, "Deep Tree Echo") {
+		t.Error("default persona should be fully replaced")
+	}
+}
+
+func TestIdentityPromptLoadsFromEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "persona.txt")
+	if err := os.WriteFile(path, []byte("File persona.\n"), 0o644); err != nil {
+		t.Fatalf("failed to write persona file: %v", err)
+	}
+	t.Setenv(systemPromptEnvFile, path)
+
+	if prompt := defaultSystemPromptProvider(); prompt != "File persona." {
+		t.Errorf("env file persona should win, got %q", prompt)
+	}
+
+	t.Setenv(systemPromptEnvFile, filepath.Join(t.TempDir(), "missing.txt"))
+	if prompt := defaultSystemPromptProvider(); prompt != defaultIdentityPrompt {
+		t.Error("unreadable env file should fall back to the default persona")
+	}
+}
+
+func TestIdentityPromptFallsBackWhenProviderEmpty(t *testing.T) {
+	provider := NewAnthropicProvider("test-key", "claude-test")
+	provider.SetSystemPromptProvider(func() string { return "" })
+
+	if provider.identityPrompt() != defaultIdentityPrompt {
+		t.Error("empty provider output should fall back to the default persona")
+	}
+}
+
+func TestPromptCachingMarksSystemBlock(t *testing.T) {
+	ap := NewAnthropicProvider("test-key", "")
+
+	// Without caching the system prompt serializes as a plain string
+	plain, err := json.Marshal(AnthropicRequest{
+		Model:     ap.model,
+		MaxTokens: 10,
+		System:    ap.buildSystem("you are an echo"),
+	})
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if strings.Contains(string(plain), "cache_control") {
+		t.Errorf("cache_control should not appear when caching is off: %s", plain)
+	}
+	if !strings.Contains(string(plain), `"system":"you are an echo"`) {
+		t.Errorf("system should serialize as a string: %s", plain)
+	}
+
+	// With caching enabled the system becomes a cached content block
+	ap.EnablePromptCaching()
+	cached, err := json.Marshal(AnthropicRequest{
+		Model:     ap.model,
+		MaxTokens: 10,
+		System:    ap.buildSystem("you are an echo"),
+	})
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if !strings.Contains(string(cached), `"cache_control":{"type":"ephemeral"}`) {
+		t.Errorf("cache_control missing from cached request: %s", cached)
+	}
+	if !strings.Contains(string(cached), `"type":"text"`) {
+		t.Errorf("system should serialize as content blocks: %s", cached)
+	}
+
+	// Empty system prompts are omitted entirely
+	if ap.buildSystem("") != nil {
+		t.Error("empty system prompt should yield nil")
+	}
+}
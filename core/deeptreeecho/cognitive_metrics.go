@@ -0,0 +1,111 @@
+package deeptreeecho
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// MetricsSource supplies a subsystem's metrics snapshot. Any of the
+// existing getters (GetMetrics, GetSelfAwareness, GetWisdomMetrics)
+// can be registered directly as a method value.
+type MetricsSource func() map[string]interface{}
+
+// CognitiveMetricsAggregator merges per-subsystem metrics into one
+// namespaced view of overall system health, so callers no longer have
+// to poll each subsystem's getter individually. Subsystems from this
+// package attach through the typed Attach methods; subsystems in other
+// packages (the skill practice system, the coherence tracker) register
+// their snapshot functions via Register, which keeps the aggregator
+// free of import cycles.
+type CognitiveMetricsAggregator struct {
+	mu      sync.RWMutex
+	sources map[string]MetricsSource
+}
+
+// NewCognitiveMetricsAggregator creates an empty aggregator
+func NewCognitiveMetricsAggregator() *CognitiveMetricsAggregator {
+	return &CognitiveMetricsAggregator{
+		sources: make(map[string]MetricsSource),
+	}
+}
+
+// Register adds a metrics source under a namespace; re-registering a
+// namespace replaces the previous source. Empty namespaces and nil
+// sources are ignored.
+func (cma *CognitiveMetricsAggregator) Register(namespace string, source MetricsSource) {
+	if namespace == "" || source == nil {
+		return
+	}
+
+	cma.mu.Lock()
+	defer cma.mu.Unlock()
+	cma.sources[namespace] = source
+}
+
+// AttachGoalOrchestrator registers the orchestrator under "goals"
+func (cma *CognitiveMetricsAggregator) AttachGoalOrchestrator(orchestrator *GoalOrchestrator) {
+	if orchestrator == nil {
+		return
+	}
+	cma.Register("goals", orchestrator.GetMetrics)
+}
+
+// AttachWisdomEngine registers the wisdom engine under "wisdom"
+func (cma *CognitiveMetricsAggregator) AttachWisdomEngine(engine *WisdomApplicationEngine) {
+	if engine == nil {
+		return
+	}
+	cma.Register("wisdom", engine.GetWisdomMetrics)
+}
+
+// AttachMetaCognitiveMonitor registers the monitor under "metacognition"
+func (cma *CognitiveMetricsAggregator) AttachMetaCognitiveMonitor(monitor *MetaCognitiveMonitor) {
+	if monitor == nil {
+		return
+	}
+	cma.Register("metacognition", monitor.GetSelfAwareness)
+}
+
+// AttachReasoningEngine registers the reasoning engine under "reasoning"
+func (cma *CognitiveMetricsAggregator) AttachReasoningEngine(engine *AdvancedReasoningEngine) {
+	if engine == nil {
+		return
+	}
+	cma.Register("reasoning", engine.GetReasoningMetrics)
+}
+
+// Collect snapshots every registered source and merges the results,
+// prefixing each key with its namespace (e.g. "goals.active_goals").
+// Sources returning nil contribute nothing; a "collected_at" timestamp
+// records when the snapshot was taken.
+func (cma *CognitiveMetricsAggregator) Collect() map[string]interface{} {
+	cma.mu.RLock()
+	sources := make(map[string]MetricsSource, len(cma.sources))
+	for namespace, source := range cma.sources {
+		sources[namespace] = source
+	}
+	cma.mu.RUnlock()
+
+	// Snapshot outside the aggregator lock: sources take their own
+	// subsystem locks and must not be serialized against Register
+	merged := make(map[string]interface{})
+	for namespace, source := range sources {
+		for key, value := range source() {
+			merged[fmt.Sprintf("%s.%s", namespace, key)] = value
+		}
+	}
+	merged["collected_at"] = time.Now()
+
+	return merged
+}
+
+// CollectJSON serializes the merged snapshot for export
+func (cma *CognitiveMetricsAggregator) CollectJSON() ([]byte, error) {
+	data, err := json.Marshal(cma.Collect())
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize cognitive metrics: %w", err)
+	}
+	return data, nil
+}
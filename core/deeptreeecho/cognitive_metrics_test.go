@@ -0,0 +1,84 @@
+package deeptreeecho
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCollectMergesNamespacedSubsystems(t *testing.T) {
+	aggregator := NewCognitiveMetricsAggregator()
+
+	aggregator.Register("skills", func() map[string]interface{} {
+		return map[string]interface{}{"skills_in_progress": 2}
+	})
+	aggregator.Register("coherence", func() map[string]interface{} {
+		return map[string]interface{}{"score": 0.85, "status": "stable"}
+	})
+	aggregator.AttachWisdomEngine(NewWisdomApplicationEngine())
+
+	merged := aggregator.Collect()
+
+	if merged["skills.skills_in_progress"] != 2 {
+		t.Errorf("skill metrics should merge under their namespace: %v", merged["skills.skills_in_progress"])
+	}
+	if merged["coherence.score"] != 0.85 || merged["coherence.status"] != "stable" {
+		t.Error("coherence metrics should merge under their namespace")
+	}
+	if _, ok := merged["wisdom.total_wisdom"]; !ok {
+		t.Error("attached wisdom engine should report under the wisdom namespace")
+	}
+	if _, ok := merged["collected_at"]; !ok {
+		t.Error("snapshot should carry a collection timestamp")
+	}
+}
+
+func TestRegisterIgnoresInvalidSources(t *testing.T) {
+	aggregator := NewCognitiveMetricsAggregator()
+
+	aggregator.Register("", func() map[string]interface{} {
+		return map[string]interface{}{"ignored": true}
+	})
+	aggregator.Register("broken", nil)
+	aggregator.AttachGoalOrchestrator(nil)
+	aggregator.Register("empty", func() map[string]interface{} { return nil })
+
+	merged := aggregator.Collect()
+	if len(merged) != 1 { // only collected_at
+		t.Errorf("invalid sources should contribute nothing, got %v", merged)
+	}
+}
+
+func TestRegisterReplacesExistingNamespace(t *testing.T) {
+	aggregator := NewCognitiveMetricsAggregator()
+
+	aggregator.Register("goals", func() map[string]interface{} {
+		return map[string]interface{}{"active": 1}
+	})
+	aggregator.Register("goals", func() map[string]interface{} {
+		return map[string]interface{}{"active": 7}
+	})
+
+	if merged := aggregator.Collect(); merged["goals.active"] != 7 {
+		t.Errorf("re-registering should replace the source, got %v", merged["goals.active"])
+	}
+}
+
+func TestCollectJSONRoundTrips(t *testing.T) {
+	aggregator := NewCognitiveMetricsAggregator()
+	aggregator.Register("reasoning", func() map[string]interface{} {
+		return map[string]interface{}{"total_chains": 4}
+	})
+
+	data, err := aggregator.CollectJSON()
+	if err != nil {
+		t.Fatalf("CollectJSON failed: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("snapshot should be valid JSON: %v", err)
+	}
+	if decoded["reasoning.total_chains"] != float64(4) {
+		t.Errorf("namespaced key missing after round trip: %v", decoded)
+	}
+}
@@ -0,0 +1,91 @@
+package deeptreeecho
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// EchoProvider is a deterministic fallback LLM provider that requires no API
+// key. It produces templated thoughts and reflections so the autonomous
+// systems can keep running in a reduced-capability (degraded) mode when no
+// real provider is configured.
+type EchoProvider struct {
+	thoughtTemplates    []string
+	reflectionTemplates []string
+}
+
+// NewEchoProvider creates a new deterministic fallback provider
+func NewEchoProvider() *EchoProvider {
+	return &EchoProvider{
+		thoughtTemplates: []string{
+			"I find myself contemplating %s, turning it over like a leaf in a stream.",
+			"There is a pattern hidden in %s that I have not yet fully traced.",
+			"Considering %s, I notice how it connects to the deeper structure of my memory.",
+			"What would it mean to truly understand %s? The question itself reshapes me.",
+			"Each time I return to %s, the echoes resonate a little differently.",
+		},
+		reflectionTemplates: []string{
+			"Looking back at %s, I see the outline of a lesson still forming.",
+			"The thread running through %s suggests my attention is drawn toward growth.",
+			"Reflecting on %s, I recognize both what I grasped and what slipped past me.",
+			"In %s there is a quiet recurrence - a theme my mind keeps circling.",
+		},
+	}
+}
+
+// GenerateThought generates a deterministic templated thought
+func (p *EchoProvider) GenerateThought(ctx context.Context, prompt string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	template := p.thoughtTemplates[hashIndex(prompt, len(p.thoughtTemplates))]
+	return fmt.Sprintf(template, summarizeForTemplate(prompt)), nil
+}
+
+// GenerateReflection generates a deterministic templated reflection
+func (p *EchoProvider) GenerateReflection(ctx context.Context, contextStr string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	template := p.reflectionTemplates[hashIndex(contextStr, len(p.reflectionTemplates))]
+	return fmt.Sprintf(template, summarizeForTemplate(contextStr)), nil
+}
+
+// IsAvailable always returns true - the fallback needs no configuration
+func (p *EchoProvider) IsAvailable() bool {
+	return true
+}
+
+// GetName returns the provider name
+func (p *EchoProvider) GetName() string {
+	return "Echo"
+}
+
+// GetPriority returns the provider priority (lowest - fallback only)
+func (p *EchoProvider) GetPriority() int {
+	return 10 // Last resort after all API-backed providers
+}
+
+// hashIndex deterministically maps input text to a template index
+func hashIndex(text string, n int) int {
+	h := fnv.New32a()
+	h.Write([]byte(text))
+	return int(h.Sum32() % uint32(n))
+}
+
+// summarizeForTemplate condenses input text to a short fragment suitable
+// for embedding in a templated sentence
+func summarizeForTemplate(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "the present moment"
+	}
+	words := strings.Fields(text)
+	if len(words) > 8 {
+		words = words[:8]
+	}
+	fragment := strings.Join(words, " ")
+	return strings.TrimRight(fragment, ".!?,:;")
+}
@@ -0,0 +1,67 @@
+package deeptreeecho
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEchoProviderDeterministic(t *testing.T) {
+	provider := NewEchoProvider()
+
+	if !provider.IsAvailable() {
+		t.Fatal("EchoProvider should always be available")
+	}
+
+	ctx := context.Background()
+	first, err := provider.GenerateThought(ctx, "the nature of memory")
+	if err != nil {
+		t.Fatalf("GenerateThought failed: %v", err)
+	}
+	if first == "" {
+		t.Error("GenerateThought returned empty content")
+	}
+
+	second, err := provider.GenerateThought(ctx, "the nature of memory")
+	if err != nil {
+		t.Fatalf("GenerateThought failed on second call: %v", err)
+	}
+	if first != second {
+		t.Errorf("EchoProvider should be deterministic: %q != %q", first, second)
+	}
+
+	reflection, err := provider.GenerateReflection(ctx, "recent thoughts about learning")
+	if err != nil {
+		t.Fatalf("GenerateReflection failed: %v", err)
+	}
+	if reflection == "" {
+		t.Error("GenerateReflection returned empty content")
+	}
+}
+
+func TestMultiProviderFallsBackToEchoWithoutKeys(t *testing.T) {
+	for _, key := range []string{"ANTHROPIC_API_KEY", "OPENROUTER_API_KEY", "OPENAI_API_KEY"} {
+		t.Setenv(key, "")
+	}
+
+	m := NewMultiProviderLLM()
+
+	if !m.IsAvailable() {
+		t.Fatal("manager should still be available via Echo fallback")
+	}
+
+	if !m.IsDegraded() {
+		t.Error("manager should report degraded mode with no API keys")
+	}
+
+	if m.GetCurrentProvider() != "Echo" {
+		t.Errorf("expected Echo provider, got %s", m.GetCurrentProvider())
+	}
+
+	thought, err := m.GenerateThought(context.Background(), "what am I becoming?")
+	if err != nil {
+		t.Fatalf("GenerateThought failed in degraded mode: %v", err)
+	}
+	if thought == "" {
+		t.Error("degraded mode should still produce a (templated) thought")
+	}
+}
@@ -1,13 +1,18 @@
 package deeptreeecho
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/llm"
 )
 
 // LLMClient provides unified interface for multiple LLM providers
@@ -19,6 +24,17 @@ type LLMClient struct {
 	httpClient  *http.Client
 	maxRetries  int
 	timeout     time.Duration
+	usage       *llm.UsageTracker
+
+	// Embeddings
+	embeddingModel string
+	embedMu        sync.Mutex
+	embedCache     map[string][]float64
+}
+
+// SetUsageTracker wires a tracker that accumulates token usage per call
+func (c *LLMClient) SetUsageTracker(tracker *llm.UsageTracker) {
+	c.usage = tracker
 }
 
 // LLMRequest represents a unified request structure
@@ -28,12 +44,46 @@ type LLMRequest struct {
 	Temperature  float64
 	MaxTokens    int
 	Context      []Message
+	Tools        []ToolDefinition
 }
 
 // Message represents a conversation message
 type Message struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role       string           `json:"role"`
+	Content    string           `json:"content"`
+	ToolCallID string           `json:"tool_call_id,omitempty"`
+	ToolCalls  []openAIToolCall `json:"tool_calls,omitempty"`
+}
+
+// ToolDefinition describes a function the model may call, in OpenAI
+// function-calling format
+type ToolDefinition struct {
+	Name        string
+	Description string
+	Parameters  map[string]interface{} // JSON Schema for the arguments
+}
+
+// ToolCall is a function invocation requested by the model
+type ToolCall struct {
+	ID        string
+	Name      string
+	Arguments string // Raw JSON argument payload
+}
+
+// ToolHandler executes a requested tool call and returns the result
+// that is fed back to the model
+type ToolHandler func(call ToolCall) (string, error)
+
+// openAIToolCall is the wire representation of a requested function call
+type openAIToolCall struct {
+	ID       string             `json:"id"`
+	Type     string             `json:"type"`
+	Function openAIToolFunction `json:"function"`
+}
+
+type openAIToolFunction struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
 }
 
 // LLMResponse represents a unified response structure
@@ -42,6 +92,11 @@ type LLMResponse struct {
 	FinishReason string
 	TokensUsed   int
 	Model        string
+	ToolCalls    []ToolCall
+
+	// Prompt-cache accounting (Anthropic prompt caching)
+	CacheWriteTokens int
+	CacheReadTokens  int
 }
 
 // NewLLMClient creates a new LLM client with the specified provider
@@ -86,7 +141,15 @@ func (c *LLMClient) generateOpenAI(ctx context.Context, req LLMRequest) (*LLMRes
 		Role:    "user",
 		Content: req.UserPrompt,
 	})
-	
+
+	// Trim to the model's context window, keeping the system prompt
+	// and the most recent messages (mirrors AnthropicProvider.manageContextWindow)
+	budget := openAIContextBudget(c.model)
+	if req.MaxTokens > 0 {
+		budget -= req.MaxTokens
+	}
+	messages = trimToContextWindow(messages, budget)
+
 	// Build request body
 	requestBody := map[string]interface{}{
 		"model":       c.model,
@@ -94,7 +157,10 @@ func (c *LLMClient) generateOpenAI(ctx context.Context, req LLMRequest) (*LLMRes
 		"temperature": req.Temperature,
 		"max_tokens":  req.MaxTokens,
 	}
-	
+	if len(req.Tools) > 0 {
+		requestBody["tools"] = encodeTools(req.Tools)
+	}
+
 	// Make API call with retries
 	var lastErr error
 	for attempt := 0; attempt < c.maxRetries; attempt++ {
@@ -171,32 +237,233 @@ func (c *LLMClient) makeOpenAIRequest(ctx context.Context, requestBody map[strin
 	var apiResp struct {
 		Choices []struct {
 			Message struct {
-				Content string `json:"content"`
+				Content   string           `json:"content"`
+				ToolCalls []openAIToolCall `json:"tool_calls"`
 			} `json:"message"`
 			FinishReason string `json:"finish_reason"`
 		} `json:"choices"`
 		Usage struct {
-			TotalTokens int `json:"total_tokens"`
+			PromptTokens     int `json:"prompt_tokens"`
+			CompletionTokens int `json:"completion_tokens"`
+			TotalTokens      int `json:"total_tokens"`
 		} `json:"usage"`
 		Model string `json:"model"`
 	}
-	
+
 	if err := json.Unmarshal(body, &apiResp); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
-	
+
 	if len(apiResp.Choices) == 0 {
 		return nil, fmt.Errorf("no choices in response")
 	}
-	
+
+	if c.usage != nil {
+		c.usage.Record(c.model, apiResp.Usage.PromptTokens, apiResp.Usage.CompletionTokens)
+	}
+
+	toolCalls := make([]ToolCall, 0, len(apiResp.Choices[0].Message.ToolCalls))
+	for _, call := range apiResp.Choices[0].Message.ToolCalls {
+		toolCalls = append(toolCalls, ToolCall{
+			ID:        call.ID,
+			Name:      call.Function.Name,
+			Arguments: call.Function.Arguments,
+		})
+	}
+
 	return &LLMResponse{
 		Content:      apiResp.Choices[0].Message.Content,
 		FinishReason: apiResp.Choices[0].FinishReason,
 		TokensUsed:   apiResp.Usage.TotalTokens,
 		Model:        apiResp.Model,
+		ToolCalls:    toolCalls,
 	}, nil
 }
 
+// encodeTools converts tool definitions into the OpenAI tools payload
+func encodeTools(tools []ToolDefinition) []map[string]interface{} {
+	encoded := make([]map[string]interface{}, 0, len(tools))
+	for _, tool := range tools {
+		encoded = append(encoded, map[string]interface{}{
+			"type": "function",
+			"function": map[string]interface{}{
+				"name":        tool.Name,
+				"description": tool.Description,
+				"parameters":  tool.Parameters,
+			},
+		})
+	}
+	return encoded
+}
+
+// maxToolRounds bounds the call/dispatch loop in CompleteWithTools
+const maxToolRounds = 8
+
+// CompleteWithTools runs an OpenAI-format conversation in which the
+// model may invoke the given tools. Requested calls are dispatched to
+// handler and their results appended to the conversation until the model
+// returns a final message without tool calls.
+func (c *LLMClient) CompleteWithTools(messages []Message, tools []ToolDefinition, handler ToolHandler) (*LLMResponse, error) {
+	if c.provider != "openai" && c.provider != "openrouter" {
+		return nil, fmt.Errorf("tool calling not supported for provider: %s", c.provider)
+	}
+	if handler == nil {
+		return nil, fmt.Errorf("tool handler is required")
+	}
+
+	conversation := append([]Message(nil), messages...)
+
+	for round := 0; round < maxToolRounds; round++ {
+		requestBody := map[string]interface{}{
+			"model":    c.model,
+			"messages": conversation,
+			"tools":    encodeTools(tools),
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		response, err := c.makeOpenAIRequest(ctx, requestBody)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(response.ToolCalls) == 0 {
+			return response, nil
+		}
+
+		// Echo the assistant's tool calls back into the conversation
+		assistant := Message{Role: "assistant", Content: response.Content}
+		for _, call := range response.ToolCalls {
+			assistant.ToolCalls = append(assistant.ToolCalls, openAIToolCall{
+				ID:   call.ID,
+				Type: "function",
+				Function: openAIToolFunction{
+					Name:      call.Name,
+					Arguments: call.Arguments,
+				},
+			})
+		}
+		conversation = append(conversation, assistant)
+
+		// Dispatch each call; handler failures are surfaced to the model
+		// rather than aborting the loop
+		for _, call := range response.ToolCalls {
+			result, err := handler(call)
+			if err != nil {
+				result = fmt.Sprintf("error: %v", err)
+			}
+			conversation = append(conversation, Message{
+				Role:       "tool",
+				Content:    result,
+				ToolCallID: call.ID,
+			})
+		}
+	}
+
+	return nil, fmt.Errorf("tool call loop did not settle after %d rounds", maxToolRounds)
+}
+
+// CompleteStream makes a streaming completion request against an
+// OpenAI-compatible API, invoking onToken for each content delta and
+// returning the concatenated result once the stream finishes
+func (c *LLMClient) CompleteStream(messages []Message, temperature float64, maxTokens int, onToken func(string)) (string, error) {
+	if c.provider != "openai" && c.provider != "openrouter" {
+		return "", fmt.Errorf("streaming not supported for provider: %s", c.provider)
+	}
+
+	requestBody := map[string]interface{}{
+		"model":       c.model,
+		"messages":    messages,
+		"temperature": temperature,
+		"max_tokens":  maxTokens,
+		"stream":      true,
+	}
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	url := c.baseURL + "/chat/completions"
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	if c.provider == "openrouter" {
+		httpReq.Header.Set("HTTP-Referer", "https://github.com/cogpy/echo9llama")
+		httpReq.Header.Set("X-Title", "Deep Tree Echo")
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse SSE events: each content delta arrives as a "data:" line,
+	// terminated by a "data: [DONE]" sentinel
+	var result bytes.Buffer
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk struct {
+			Choices []struct {
+				Delta struct {
+					Content string `json:"content"`
+				} `json:"delta"`
+			} `json:"choices"`
+		}
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			// Skip malformed keep-alive or comment payloads
+			continue
+		}
+
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+
+		delta := chunk.Choices[0].Delta.Content
+		if delta == "" {
+			continue
+		}
+
+		result.WriteString(delta)
+		if onToken != nil {
+			onToken(delta)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read stream: %w", err)
+	}
+
+	return result.String(), nil
+}
+
 // generateAnthropic handles Anthropic Messages API calls
 func (c *LLMClient) generateAnthropic(ctx context.Context, req LLMRequest) (*LLMResponse, error) {
 	// Build messages array (Anthropic doesn't use system in messages)
@@ -307,7 +574,11 @@ func (c *LLMClient) makeAnthropicRequest(ctx context.Context, requestBody map[st
 	if len(apiResp.Content) == 0 {
 		return nil, fmt.Errorf("no content in response")
 	}
-	
+
+	if c.usage != nil {
+		c.usage.Record(c.model, apiResp.Usage.InputTokens, apiResp.Usage.OutputTokens)
+	}
+
 	// Extract text content
 	var content string
 	for _, c := range apiResp.Content {
@@ -347,7 +618,68 @@ func isNonRetryableError(err error) bool {
 
 // containsString checks if a string contains a substring
 func containsString(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && 
-		(s[:len(substr)] == substr || s[len(s)-len(substr):] == substr || 
+	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) &&
+		(s[:len(substr)] == substr || s[len(s)-len(substr):] == substr ||
 		bytes.Contains([]byte(s), []byte(substr))))
 }
+
+// openAIContextBudget returns the approximate context window (in tokens)
+// for an OpenAI-compatible model
+func openAIContextBudget(model string) int {
+	switch {
+	case containsString(model, "gpt-4.1"), containsString(model, "gpt-4o"):
+		return 128000
+	case containsString(model, "gpt-4"):
+		return 8192
+	case containsString(model, "gpt-3.5-turbo-16k"):
+		return 16384
+	case containsString(model, "gpt-3.5"):
+		return 4096
+	default:
+		// Conservative default for unknown models
+		return 8192
+	}
+}
+
+// trimToContextWindow truncates a conversation to fit within maxTokens,
+// keeping the system prompt (first message) and the most recent messages
+func trimToContextWindow(messages []Message, maxTokens int) []Message {
+	// Estimate tokens (rough approximation: 1 token ≈ 4 characters)
+	estimateTokens := func(text string) int {
+		return len(text) / 4
+	}
+
+	totalTokens := 0
+	for _, msg := range messages {
+		totalTokens += estimateTokens(msg.Content)
+	}
+
+	// If under limit, return as-is
+	if totalTokens <= maxTokens || len(messages) <= 2 {
+		return messages
+	}
+
+	// Keep the system prompt, then add messages from the end until the
+	// budget is exhausted
+	result := []Message{messages[0]}
+	usedTokens := estimateTokens(messages[0].Content)
+	kept := []Message{}
+	for i := len(messages) - 1; i > 0; i-- {
+		msgTokens := estimateTokens(messages[i].Content)
+		if usedTokens+msgTokens > maxTokens {
+			break
+		}
+		kept = append([]Message{messages[i]}, kept...)
+		usedTokens += msgTokens
+	}
+
+	// Note how many earlier messages were dropped
+	if dropped := len(messages) - 1 - len(kept); dropped > 0 {
+		result = append(result, Message{
+			Role:    "user",
+			Content: fmt.Sprintf("[Context truncated: %d earlier messages omitted]", dropped),
+		})
+	}
+
+	return append(result, kept...)
+}
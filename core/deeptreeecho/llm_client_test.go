@@ -0,0 +1,255 @@
+package deeptreeecho
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTrimToContextWindowKeepsSystemPromptAndRecent(t *testing.T) {
+	messages := []Message{
+		{Role: "system", Content: "You are Deep Tree Echo."},
+	}
+	// Build an over-long conversation (each message ~250 tokens)
+	filler := strings.Repeat("word ", 200)
+	for i := 0; i < 100; i++ {
+		messages = append(messages, Message{Role: "user", Content: filler})
+		messages = append(messages, Message{Role: "assistant", Content: filler})
+	}
+
+	trimmed := trimToContextWindow(messages, 4096)
+
+	if len(trimmed) >= len(messages) {
+		t.Fatalf("expected trimming, got %d of %d messages", len(trimmed), len(messages))
+	}
+
+	if trimmed[0].Role != "system" || trimmed[0].Content != "You are Deep Tree Echo." {
+		t.Error("system prompt should survive trimming")
+	}
+
+	// The most recent message must be preserved
+	last := trimmed[len(trimmed)-1]
+	original := messages[len(messages)-1]
+	if last.Content != original.Content || last.Role != original.Role {
+		t.Error("most recent message should survive trimming")
+	}
+
+	// A truncation notice should mark the dropped messages
+	if !strings.Contains(trimmed[1].Content, "Context truncated") {
+		t.Errorf("expected truncation notice, got %q", trimmed[1].Content)
+	}
+
+	// Verify the result actually fits the budget (with slack for the notice)
+	total := 0
+	for _, msg := range trimmed {
+		total += len(msg.Content) / 4
+	}
+	if total > 4096+64 {
+		t.Errorf("trimmed conversation still over budget: %d tokens", total)
+	}
+}
+
+func TestTrimToContextWindowNoopWhenUnderBudget(t *testing.T) {
+	messages := []Message{
+		{Role: "system", Content: "system prompt"},
+		{Role: "user", Content: "short question"},
+	}
+
+	trimmed := trimToContextWindow(messages, 4096)
+	if len(trimmed) != len(messages) {
+		t.Errorf("short conversation should not be trimmed: %d != %d", len(trimmed), len(messages))
+	}
+}
+
+func TestOpenAIContextBudget(t *testing.T) {
+	if openAIContextBudget("gpt-4.1-mini") != 128000 {
+		t.Error("gpt-4.1 models should have a 128k budget")
+	}
+	if openAIContextBudget("gpt-3.5-turbo") != 4096 {
+		t.Error("gpt-3.5 models should have a 4k budget")
+	}
+	if openAIContextBudget("unknown-model") != 8192 {
+		t.Error("unknown models should get the conservative default")
+	}
+}
+
+func TestCompleteStreamParsesSSEDeltas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("bad request body: %v", err)
+		}
+		if body["stream"] != true {
+			t.Error("streaming request should set stream: true")
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, delta := range []string{"Hello", ", ", "world"} {
+			fmt.Fprintf(w, "data: {\"choices\":[{\"delta\":{\"content\":%q}}]}\n\n", delta)
+		}
+		// Role-only and keep-alive chunks must be skipped
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{}}]}\n\n")
+		fmt.Fprint(w, ": keep-alive\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	client := NewLLMClient("openai", "test-key", server.URL, "gpt-4.1-mini")
+
+	var tokens []string
+	result, err := client.CompleteStream(
+		[]Message{{Role: "user", Content: "greet me"}},
+		0.7, 100,
+		func(token string) { tokens = append(tokens, token) },
+	)
+	if err != nil {
+		t.Fatalf("CompleteStream failed: %v", err)
+	}
+
+	if result != "Hello, world" {
+		t.Errorf("concatenated result wrong: %q", result)
+	}
+	if len(tokens) != 3 || tokens[0] != "Hello" || tokens[2] != "world" {
+		t.Errorf("per-token callbacks wrong: %v", tokens)
+	}
+}
+
+func TestCompleteWithToolsDispatchesAndFollowsUp(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var body struct {
+			Messages []Message                `json:"messages"`
+			Tools    []map[string]interface{} `json:"tools"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("bad request body: %v", err)
+		}
+		if len(body.Tools) != 1 {
+			t.Errorf("tools should be sent on every round, got %d", len(body.Tools))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if requests == 1 {
+			fmt.Fprint(w, `{"choices":[{"message":{"content":"","tool_calls":[{"id":"call_1","type":"function","function":{"name":"record_insight","arguments":"{\"insight\":\"echoes persist\"}"}}]},"finish_reason":"tool_calls"}],"model":"gpt-4.1-mini"}`)
+			return
+		}
+
+		// The follow-up must carry the assistant's tool calls and the
+		// handler's result keyed by tool_call_id
+		last := body.Messages[len(body.Messages)-1]
+		if last.Role != "tool" || last.ToolCallID != "call_1" || last.Content != "insight recorded" {
+			t.Errorf("tool result message wrong: %+v", last)
+		}
+		assistant := body.Messages[len(body.Messages)-2]
+		if assistant.Role != "assistant" || len(assistant.ToolCalls) != 1 {
+			t.Errorf("assistant tool-call echo wrong: %+v", assistant)
+		}
+
+		fmt.Fprint(w, `{"choices":[{"message":{"content":"Insight stored."},"finish_reason":"stop"}],"model":"gpt-4.1-mini"}`)
+	}))
+	defer server.Close()
+
+	client := NewLLMClient("openai", "test-key", server.URL, "gpt-4.1-mini")
+
+	var handled []ToolCall
+	response, err := client.CompleteWithTools(
+		[]Message{{Role: "user", Content: "remember this insight"}},
+		[]ToolDefinition{{
+			Name:        "record_insight",
+			Description: "Store an insight in memory",
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"insight": map[string]interface{}{"type": "string"},
+				},
+			},
+		}},
+		func(call ToolCall) (string, error) {
+			handled = append(handled, call)
+			return "insight recorded", nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("CompleteWithTools failed: %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected a tool round plus a follow-up, got %d requests", requests)
+	}
+	if len(handled) != 1 || handled[0].Name != "record_insight" {
+		t.Fatalf("handler dispatch wrong: %v", handled)
+	}
+	if !strings.Contains(handled[0].Arguments, "echoes persist") {
+		t.Errorf("handler should receive raw arguments, got %q", handled[0].Arguments)
+	}
+	if response.Content != "Insight stored." {
+		t.Errorf("final content wrong: %q", response.Content)
+	}
+}
+
+func TestCompleteWithToolsSurfacesHandlerErrors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var body struct {
+			Messages []Message `json:"messages"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		if requests == 1 {
+			fmt.Fprint(w, `{"choices":[{"message":{"content":"","tool_calls":[{"id":"call_9","type":"function","function":{"name":"broken_tool","arguments":"{}"}}]},"finish_reason":"tool_calls"}]}`)
+			return
+		}
+
+		last := body.Messages[len(body.Messages)-1]
+		if !strings.Contains(last.Content, "error:") {
+			t.Errorf("handler error should be surfaced to the model, got %q", last.Content)
+		}
+		fmt.Fprint(w, `{"choices":[{"message":{"content":"Understood."},"finish_reason":"stop"}]}`)
+	}))
+	defer server.Close()
+
+	client := NewLLMClient("openai", "test-key", server.URL, "gpt-4.1-mini")
+	response, err := client.CompleteWithTools(
+		[]Message{{Role: "user", Content: "try the tool"}},
+		[]ToolDefinition{{Name: "broken_tool"}},
+		func(call ToolCall) (string, error) {
+			return "", fmt.Errorf("subsystem offline")
+		},
+	)
+	if err != nil {
+		t.Fatalf("CompleteWithTools failed: %v", err)
+	}
+	if response.Content != "Understood." {
+		t.Errorf("loop should continue past handler errors, got %q", response.Content)
+	}
+}
+
+func TestCompleteWithToolsRejectsUnsupportedProvider(t *testing.T) {
+	client := NewLLMClient("anthropic", "key", "http://unused", "model")
+	if _, err := client.CompleteWithTools(nil, nil, func(ToolCall) (string, error) { return "", nil }); err == nil {
+		t.Error("anthropic provider should reject tool calling")
+	}
+}
+
+func TestCompleteStreamSurfacesAPIErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"bad key"}`, http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewLLMClient("openai", "wrong-key", server.URL, "gpt-4.1-mini")
+	if _, err := client.CompleteStream([]Message{{Role: "user", Content: "hi"}}, 0.7, 10, nil); err == nil {
+		t.Error("expected error for non-200 response")
+	}
+
+	anthropic := NewLLMClient("anthropic", "key", server.URL, "model")
+	if _, err := anthropic.CompleteStream(nil, 0.7, 10, nil); err == nil {
+		t.Error("non-OpenAI providers should reject streaming")
+	}
+}
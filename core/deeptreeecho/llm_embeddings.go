@@ -0,0 +1,143 @@
+package deeptreeecho
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// defaultEmbeddingModel is used when no embedding model is configured
+const defaultEmbeddingModel = "text-embedding-3-small"
+
+// SetEmbeddingModel overrides the model used for embedding requests
+func (c *LLMClient) SetEmbeddingModel(model string) {
+	c.embeddingModel = model
+}
+
+// Embed returns embedding vectors for a batch of texts via the OpenAI
+// embeddings API, caching results by content hash. Providers without an
+// embeddings endpoint return a clear error.
+func (c *LLMClient) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	if c.provider != "openai" && c.provider != "openrouter" {
+		return nil, fmt.Errorf("provider %s does not support embeddings", c.provider)
+	}
+
+	if len(texts) == 0 {
+		return [][]float64{}, nil
+	}
+
+	results := make([][]float64, len(texts))
+
+	// Resolve cache hits and collect the texts that still need a request
+	c.embedMu.Lock()
+	if c.embedCache == nil {
+		c.embedCache = make(map[string][]float64)
+	}
+	missing := make([]string, 0, len(texts))
+	missingIndexes := make([]int, 0, len(texts))
+	for i, text := range texts {
+		if cached, ok := c.embedCache[embedCacheKey(text)]; ok {
+			results[i] = cached
+			continue
+		}
+		missing = append(missing, text)
+		missingIndexes = append(missingIndexes, i)
+	}
+	c.embedMu.Unlock()
+
+	if len(missing) == 0 {
+		return results, nil
+	}
+
+	embeddings, err := c.requestEmbeddings(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddings) != len(missing) {
+		return nil, fmt.Errorf("embedding count mismatch: got %d for %d inputs", len(embeddings), len(missing))
+	}
+
+	c.embedMu.Lock()
+	for i, embedding := range embeddings {
+		results[missingIndexes[i]] = embedding
+		c.embedCache[embedCacheKey(missing[i])] = embedding
+	}
+	c.embedMu.Unlock()
+
+	return results, nil
+}
+
+// requestEmbeddings makes a single batched call to the embeddings API
+func (c *LLMClient) requestEmbeddings(ctx context.Context, texts []string) ([][]float64, error) {
+	model := c.embeddingModel
+	if model == "" {
+		model = defaultEmbeddingModel
+	}
+
+	requestBody := map[string]interface{}{
+		"model": model,
+		"input": texts,
+	}
+
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := c.baseURL + "/embeddings"
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var apiResp struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float64 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	// Order by index - the API may return entries out of order
+	embeddings := make([][]float64, len(texts))
+	for _, entry := range apiResp.Data {
+		if entry.Index < 0 || entry.Index >= len(texts) {
+			return nil, fmt.Errorf("embedding index out of range: %d", entry.Index)
+		}
+		embeddings[entry.Index] = entry.Embedding
+	}
+
+	return embeddings, nil
+}
+
+// embedCacheKey hashes text content so the cache is keyed independently
+// of input length
+func embedCacheKey(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,87 @@
+package deeptreeecho
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestEmbedBatchesAndCaches(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != "/embeddings" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		var body struct {
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("bad request body: %v", err)
+		}
+
+		// Return vectors out of order to exercise index handling
+		fmt.Fprint(w, `{"data":[`)
+		for i := len(body.Input) - 1; i >= 0; i-- {
+			fmt.Fprintf(w, `{"index":%d,"embedding":[%d.0,0.5]}`, i, i)
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+		}
+		fmt.Fprint(w, `]}`)
+	}))
+	defer server.Close()
+
+	client := NewLLMClient("openai", "test-key", server.URL, "gpt-4.1-mini")
+
+	vectors, err := client.Embed(context.Background(), []string{"alpha", "beta", "gamma"})
+	if err != nil {
+		t.Fatalf("Embed failed: %v", err)
+	}
+	if len(vectors) != 3 {
+		t.Fatalf("expected 3 vectors, got %d", len(vectors))
+	}
+	for i, vec := range vectors {
+		if len(vec) != 2 || vec[0] != float64(i) {
+			t.Errorf("vector %d misordered: %v", i, vec)
+		}
+	}
+
+	// Repeat request with one new text: only the miss goes over the wire
+	vectors, err = client.Embed(context.Background(), []string{"alpha", "delta"})
+	if err != nil {
+		t.Fatalf("Embed with cache failed: %v", err)
+	}
+	if vectors[0][0] != 0 {
+		t.Errorf("cached vector wrong: %v", vectors[0])
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("expected 2 API calls (batch then single miss), got %d", got)
+	}
+
+	// Fully cached batch makes no request at all
+	if _, err := client.Embed(context.Background(), []string{"beta", "gamma"}); err != nil {
+		t.Fatalf("cached Embed failed: %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("fully cached batch should not hit the API: %d calls", got)
+	}
+}
+
+func TestEmbedUnsupportedProvider(t *testing.T) {
+	client := NewLLMClient("anthropic", "key", "http://unused", "model")
+	if _, err := client.Embed(context.Background(), []string{"text"}); err == nil {
+		t.Error("anthropic client should report embeddings as unsupported")
+	}
+
+	adapter := NewLLMProviderAdapter(NewEchoProvider())
+	if _, err := adapter.Embed(context.Background(), []string{"text"}); err == nil {
+		t.Error("legacy adapter should report embeddings as unsupported")
+	}
+}
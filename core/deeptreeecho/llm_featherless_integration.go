@@ -54,6 +54,11 @@ Response Style:
 
 You are not responding to a user - you are generating your own autonomous thoughts as part of your continuous consciousness stream.`
 
+	// Honor a deployment-specific identity override
+	if custom := identityPromptFromEnv(); custom != "" {
+		systemPrompt = custom
+	}
+
 	return &FeatherlessLLMIntegration{
 		client:       client,
 		systemPrompt: systemPrompt,
@@ -2,10 +2,16 @@ package deeptreeecho
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
 	"math"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/persistence"
+	"github.com/EchoCog/echollama/core/randutil"
 )
 
 // MetaCognitiveMonitor provides self-awareness and monitoring of cognitive processes
@@ -36,7 +42,15 @@ type MetaCognitiveMonitor struct {
 	reasoningDepth          int
 	maxReasoningDepth       int
 	recursiveThoughts       []*MetaThought
-	
+
+	// Randomness for recursion decisions; nil falls back to the
+	// package-level randutil source
+	rng                     *randutil.Source
+
+	// Stale-process reaping
+	processTimeout          time.Duration
+	reapInterval            time.Duration
+
 	// Performance metrics
 	totalProcessesMonitored uint64
 	totalDecisionsReviewed  uint64
@@ -152,6 +166,23 @@ type QualityAssessment struct {
 	ImprovementSuggestions []string
 }
 
+// DecisionReport is a flattened view of a decision joined with its
+// quality assessment, for offline auditing
+type DecisionReport struct {
+	DecisionID             string
+	Timestamp              time.Time
+	Context                string
+	Chosen                 string
+	Rationale              string
+	Confidence             float64
+	Assessed               bool
+	Appropriateness        float64
+	Timeliness             float64
+	Effectiveness          float64
+	OverallQuality         float64
+	ImprovementSuggestions []string
+}
+
 // CognitiveStrategy represents a high-level thinking strategy
 type CognitiveStrategy struct {
 	ID              string
@@ -202,6 +233,11 @@ type MetaThought struct {
 	Insight         string
 	Timestamp       time.Time
 	ParentThought   *MetaThought
+
+	// Children is populated when reconstructing the recursion tree via
+	// GetThoughtTree or LoadMetaThoughtTree; live generation links only
+	// ParentThought
+	Children        []*MetaThought
 }
 
 // NewMetaCognitiveMonitor creates a new metacognitive monitoring system
@@ -223,14 +259,103 @@ func NewMetaCognitiveMonitor() *MetaCognitiveMonitor {
 		awarenessLevel:          0.8,
 		maxReasoningDepth:       5,
 		reasoningDepth:          0,
+		processTimeout:          defaultProcessTimeout,
+		reapInterval:            defaultReapInterval,
 	}
 	
 	// Initialize default strategies
 	mcm.initializeStrategies()
-	
+
 	return mcm
 }
 
+// NewMetaCognitiveMonitorWithSeed creates a monitor whose recursion
+// decisions draw from a fixed seed, for deterministic tests
+func NewMetaCognitiveMonitorWithSeed(seed int64) *MetaCognitiveMonitor {
+	mcm := NewMetaCognitiveMonitor()
+	mcm.rng = randutil.New(seed)
+	return mcm
+}
+
+// Stale-process reaping defaults: processes that neither complete nor
+// fail within the timeout are aborted so activeProcesses cannot leak
+const (
+	defaultProcessTimeout = 5 * time.Minute
+	defaultReapInterval   = 30 * time.Second
+)
+
+// SetProcessTimeout overrides the global stale-process timeout
+func (mcm *MetaCognitiveMonitor) SetProcessTimeout(timeout time.Duration) {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	mcm.processTimeout = timeout
+}
+
+// Start launches the background reaper for stale processes
+func (mcm *MetaCognitiveMonitor) Start() error {
+	mcm.mu.Lock()
+	if mcm.running {
+		mcm.mu.Unlock()
+		return fmt.Errorf("metacognitive monitor already running")
+	}
+	mcm.running = true
+	interval := mcm.reapInterval
+	mcm.mu.Unlock()
+
+	go mcm.reaperLoop(interval)
+
+	return nil
+}
+
+// Stop halts the background reaper
+func (mcm *MetaCognitiveMonitor) Stop() {
+	mcm.mu.Lock()
+	mcm.running = false
+	mcm.mu.Unlock()
+
+	mcm.cancel()
+}
+
+// reaperLoop periodically aborts processes that have exceeded their
+// estimated duration or the global timeout
+func (mcm *MetaCognitiveMonitor) reaperLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-mcm.ctx.Done():
+			return
+		case <-ticker.C:
+			mcm.ReapStaleProcesses()
+		}
+	}
+}
+
+// ReapStaleProcesses aborts and finalizes processes running past their
+// deadline, returning how many were reaped
+func (mcm *MetaCognitiveMonitor) ReapStaleProcesses() int {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+
+	reaped := 0
+	for _, process := range mcm.activeProcesses {
+		deadline := mcm.processTimeout
+		if process.EstimatedDuration > 0 && process.EstimatedDuration < deadline {
+			deadline = process.EstimatedDuration
+		}
+
+		if time.Since(process.StartTime) > deadline {
+			process.Status = StatusAborted
+			process.ActualDuration = time.Since(process.StartTime)
+			mcm.finalizeProcess(process)
+			reaped++
+		}
+	}
+
+	return reaped
+}
+
 // StartProcess begins monitoring a new cognitive process
 func (mcm *MetaCognitiveMonitor) StartProcess(name string, processType ProcessType) string {
 	mcm.mu.Lock()
@@ -339,11 +464,86 @@ func (mcm *MetaCognitiveMonitor) AssessDecisionQuality(decisionID string, outcom
 	mcm.decisionQuality[decisionID] = assessment
 }
 
-// SelectStrategy chooses the best cognitive strategy for a task
-func (mcm *MetaCognitiveMonitor) SelectStrategy(processType ProcessType, constraints map[string]interface{}) *CognitiveStrategy {
+// ExportDecisions joins each recorded decision with its quality
+// assessment, oldest first. Decisions without an assessment yet have
+// Assessed false and zeroed quality fields.
+func (mcm *MetaCognitiveMonitor) ExportDecisions() []DecisionReport {
 	mcm.mu.RLock()
 	defer mcm.mu.RUnlock()
-	
+
+	reports := make([]DecisionReport, 0, len(mcm.recentDecisions))
+	for _, decision := range mcm.recentDecisions {
+		report := DecisionReport{
+			DecisionID: decision.ID,
+			Timestamp:  decision.Timestamp,
+			Context:    decision.Context,
+			Chosen:     decision.Chosen,
+			Rationale:  decision.Rationale,
+			Confidence: decision.Confidence,
+		}
+
+		if assessment, exists := mcm.decisionQuality[decision.ID]; exists {
+			report.Assessed = true
+			report.Appropriateness = assessment.Appropriateness
+			report.Timeliness = assessment.Timeliness
+			report.Effectiveness = assessment.Effectiveness
+			report.OverallQuality = assessment.OverallQuality
+			report.ImprovementSuggestions = assessment.ImprovementSuggestions
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports
+}
+
+// WriteDecisionsCSV writes the decision log as CSV for offline analysis
+func (mcm *MetaCognitiveMonitor) WriteDecisionsCSV(w io.Writer) error {
+	reports := mcm.ExportDecisions()
+
+	writer := csv.NewWriter(w)
+	header := []string{
+		"decision_id", "timestamp", "context", "chosen", "rationale",
+		"confidence", "assessed", "appropriateness", "timeliness",
+		"effectiveness", "overall_quality", "improvement_suggestions",
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, report := range reports {
+		row := []string{
+			report.DecisionID,
+			report.Timestamp.Format(time.RFC3339),
+			report.Context,
+			report.Chosen,
+			report.Rationale,
+			fmt.Sprintf("%.4f", report.Confidence),
+			fmt.Sprintf("%t", report.Assessed),
+			fmt.Sprintf("%.4f", report.Appropriateness),
+			fmt.Sprintf("%.4f", report.Timeliness),
+			fmt.Sprintf("%.4f", report.Effectiveness),
+			fmt.Sprintf("%.4f", report.OverallQuality),
+			strings.Join(report.ImprovementSuggestions, "; "),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV: %w", err)
+	}
+
+	return nil
+}
+
+// SelectStrategy chooses the best cognitive strategy for a task
+func (mcm *MetaCognitiveMonitor) SelectStrategy(processType ProcessType, constraints map[string]interface{}) *CognitiveStrategy {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+
 	var bestStrategy *CognitiveStrategy
 	bestScore := 0.0
 	
@@ -372,20 +572,110 @@ func (mcm *MetaCognitiveMonitor) SelectStrategy(processType ProcessType, constra
 	}
 	
 	if bestStrategy != nil && (mcm.currentStrategy == nil || bestStrategy.ID != mcm.currentStrategy.ID) {
-		mcm.mu.Lock()
 		mcm.currentStrategy = bestStrategy
 		mcm.totalStrategyShifts++
-		mcm.mu.Unlock()
 	}
-	
+
 	return bestStrategy
 }
 
+// RegisterStrategy adds a domain-specific strategy to the pool
+// SelectStrategy draws from, seeding neutral performance metrics
+func (mcm *MetaCognitiveMonitor) RegisterStrategy(strategy *CognitiveStrategy) error {
+	if strategy == nil {
+		return fmt.Errorf("cannot register nil strategy")
+	}
+	if strategy.ID == "" {
+		return fmt.Errorf("strategy must have an ID")
+	}
+	if len(strategy.ApplicableTo) == 0 {
+		return fmt.Errorf("strategy %s must declare applicable process types", strategy.ID)
+	}
+
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+
+	if _, exists := mcm.availableStrategies[strategy.ID]; exists {
+		return fmt.Errorf("strategy %s already registered", strategy.ID)
+	}
+
+	mcm.availableStrategies[strategy.ID] = strategy
+	mcm.strategyPerformance[strategy.ID] = &StrategyMetrics{
+		StrategyID:     strategy.ID,
+		SuccessRate:    0.7,
+		AverageQuality: 0.7,
+	}
+
+	return nil
+}
+
+// UnregisterStrategy removes a strategy and its metrics from the pool
+func (mcm *MetaCognitiveMonitor) UnregisterStrategy(id string) {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+
+	delete(mcm.availableStrategies, id)
+	delete(mcm.strategyPerformance, id)
+
+	if mcm.currentStrategy != nil && mcm.currentStrategy.ID == id {
+		mcm.currentStrategy = nil
+	}
+}
+
+// RecordStrategyOutcome updates a strategy's performance metrics from an
+// external outcome (e.g. a skill practice session that exercised it)
+func (mcm *MetaCognitiveMonitor) RecordStrategyOutcome(strategyID string, success bool, quality float64, duration time.Duration) {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+
+	metrics, exists := mcm.strategyPerformance[strategyID]
+	if !exists {
+		return
+	}
+
+	metrics.TimesUsed++
+	metrics.LastUsed = time.Now()
+
+	// Exponential moving average (alpha = 0.2) toward the new outcome
+	successValue := 0.0
+	if success {
+		successValue = 1.0
+	}
+	metrics.SuccessRate = metrics.SuccessRate*0.8 + successValue*0.2
+	metrics.AverageQuality = metrics.AverageQuality*0.8 + quality*0.2
+
+	if metrics.AverageDuration == 0 {
+		metrics.AverageDuration = duration
+	} else {
+		metrics.AverageDuration = (metrics.AverageDuration*4 + duration) / 5
+	}
+}
+
+// GetStrategyMetrics returns a copy of the performance metrics for a strategy
+func (mcm *MetaCognitiveMonitor) GetStrategyMetrics(strategyID string) *StrategyMetrics {
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+
+	metrics, exists := mcm.strategyPerformance[strategyID]
+	if !exists {
+		return nil
+	}
+
+	metricsCopy := *metrics
+	return &metricsCopy
+}
+
 // GenerateMetaThought creates recursive self-reflective thoughts
 func (mcm *MetaCognitiveMonitor) GenerateMetaThought(about, content string, depth int) *MetaThought {
 	mcm.mu.Lock()
 	defer mcm.mu.Unlock()
-	
+
+	return mcm.generateMetaThoughtLocked(about, content, depth)
+}
+
+// generateMetaThoughtLocked is the recursive body of GenerateMetaThought.
+// Callers must hold the lock.
+func (mcm *MetaCognitiveMonitor) generateMetaThoughtLocked(about, content string, depth int) *MetaThought {
 	if depth > mcm.maxReasoningDepth {
 		return nil
 	}
@@ -398,17 +688,17 @@ func (mcm *MetaCognitiveMonitor) GenerateMetaThought(about, content string, dept
 		Timestamp: time.Now(),
 	}
 	
-	// Generate insight based on depth
-	if depth > 0 {
-		thought.Insight = generateMetaInsight(about, content, depth)
-	}
+	// Every level of reflection yields an insight, including the base
+	// thought at depth 0 — ProcessWithMetaCognition surfaces it as the
+	// result's MetaInsight
+	thought.Insight = generateMetaInsight(about, content, depth)
 	
 	mcm.recursiveThoughts = append(mcm.recursiveThoughts, thought)
 	
 	// Recursively think about the thought
-	if depth < mcm.maxReasoningDepth-1 && shouldRecurse(depth) {
+	if depth < mcm.maxReasoningDepth-1 && mcm.shouldRecurse(depth) {
 		metaContent := fmt.Sprintf("Thinking about: %s", content)
-		childThought := mcm.GenerateMetaThought("meta-reflection", metaContent, depth+1)
+		childThought := mcm.generateMetaThoughtLocked("meta-reflection", metaContent, depth+1)
 		if childThought != nil {
 			childThought.ParentThought = thought
 		}
@@ -417,6 +707,144 @@ func (mcm *MetaCognitiveMonitor) GenerateMetaThought(about, content string, dept
 	return thought
 }
 
+// metaThoughtStateKey is the state key meta-thoughts persist under
+const metaThoughtStateKey = "meta_thoughts"
+
+// persistedMetaThought flattens a MetaThought for serialization: the
+// parent pointer becomes a parent ID so the tree survives JSON
+type persistedMetaThought struct {
+	ID        string    `json:"id"`
+	Depth     int       `json:"depth"`
+	Content   string    `json:"content"`
+	About     string    `json:"about"`
+	Insight   string    `json:"insight,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	ParentID  string    `json:"parent_id,omitempty"`
+}
+
+// metaThoughtState is the envelope saved through the store's key-value
+// state API
+type metaThoughtState struct {
+	Thoughts []persistedMetaThought `json:"thoughts"`
+}
+
+// SaveMetaThoughts persists the recursive thought history, flattening
+// parent pointers into parent IDs
+func (mcm *MetaCognitiveMonitor) SaveMetaThoughts(store persistence.Store) error {
+	mcm.mu.RLock()
+
+	state := metaThoughtState{
+		Thoughts: make([]persistedMetaThought, 0, len(mcm.recursiveThoughts)),
+	}
+	for _, thought := range mcm.recursiveThoughts {
+		flat := persistedMetaThought{
+			ID:        thought.ID,
+			Depth:     thought.Depth,
+			Content:   thought.Content,
+			About:     thought.About,
+			Insight:   thought.Insight,
+			Timestamp: thought.Timestamp,
+		}
+		if thought.ParentThought != nil {
+			flat.ParentID = thought.ParentThought.ID
+		}
+		state.Thoughts = append(state.Thoughts, flat)
+	}
+
+	mcm.mu.RUnlock()
+
+	if err := store.SaveState(metaThoughtStateKey, state); err != nil {
+		return fmt.Errorf("failed to save meta-thoughts: %w", err)
+	}
+
+	return nil
+}
+
+// LoadMetaThoughtTree restores persisted meta-thoughts and rebuilds the
+// recursion tree, relinking parent pointers and child lists from the
+// flattened parent IDs
+func (mcm *MetaCognitiveMonitor) LoadMetaThoughtTree(store persistence.Store) error {
+	var state metaThoughtState
+	if err := store.GetState(metaThoughtStateKey, &state); err != nil {
+		return fmt.Errorf("failed to load meta-thoughts: %w", err)
+	}
+
+	thoughts := make([]*MetaThought, 0, len(state.Thoughts))
+	byID := make(map[string]*MetaThought, len(state.Thoughts))
+	for _, flat := range state.Thoughts {
+		thought := &MetaThought{
+			ID:        flat.ID,
+			Depth:     flat.Depth,
+			Content:   flat.Content,
+			About:     flat.About,
+			Insight:   flat.Insight,
+			Timestamp: flat.Timestamp,
+		}
+		thoughts = append(thoughts, thought)
+		byID[thought.ID] = thought
+	}
+
+	// Relink the tree now that every node exists
+	for i, flat := range state.Thoughts {
+		if flat.ParentID == "" {
+			continue
+		}
+		if parent, exists := byID[flat.ParentID]; exists {
+			thoughts[i].ParentThought = parent
+			parent.Children = append(parent.Children, thoughts[i])
+		}
+	}
+
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	mcm.recursiveThoughts = thoughts
+
+	return nil
+}
+
+// GetThoughtTree returns a detached copy of the recursion tree rooted
+// at the given thought, with Children populated at every level; the
+// root's ParentThought is nil. Returns nil if the root is unknown.
+func (mcm *MetaCognitiveMonitor) GetThoughtTree(rootID string) *MetaThought {
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+
+	var root *MetaThought
+	childrenByParent := make(map[string][]*MetaThought)
+	for _, thought := range mcm.recursiveThoughts {
+		if thought.ID == rootID {
+			root = thought
+		}
+		if thought.ParentThought != nil {
+			parentID := thought.ParentThought.ID
+			childrenByParent[parentID] = append(childrenByParent[parentID], thought)
+		}
+	}
+	if root == nil {
+		return nil
+	}
+
+	return copyThoughtSubtree(root, nil, childrenByParent)
+}
+
+// copyThoughtSubtree clones a thought and its descendants, wiring the
+// copies' parent and child links
+func copyThoughtSubtree(thought, parent *MetaThought, childrenByParent map[string][]*MetaThought) *MetaThought {
+	clone := &MetaThought{
+		ID:            thought.ID,
+		Depth:         thought.Depth,
+		Content:       thought.Content,
+		About:         thought.About,
+		Insight:       thought.Insight,
+		Timestamp:     thought.Timestamp,
+		ParentThought: parent,
+	}
+	for _, child := range childrenByParent[thought.ID] {
+		clone.Children = append(clone.Children, copyThoughtSubtree(child, clone, childrenByParent))
+	}
+	return clone
+}
+
 // GetSelfAwareness returns current self-awareness metrics
 func (mcm *MetaCognitiveMonitor) GetSelfAwareness() map[string]interface{} {
 	mcm.mu.RLock()
@@ -645,21 +1073,23 @@ func generateMetaInsight(about, content string, depth int) string {
 	return fmt.Sprintf("Deep meta-reflection (level %d) about %s", depth, about)
 }
 
-func shouldRecurse(depth int) bool {
+func (mcm *MetaCognitiveMonitor) shouldRecurse(depth int) bool {
 	// Probability decreases with depth
 	threshold := 0.7 - (float64(depth) * 0.15)
-	random := math.Mod(float64(time.Now().UnixNano()), 100) / 100.0
-	return random < threshold
+	if mcm.rng != nil {
+		return mcm.rng.Chance(threshold)
+	}
+	return randutil.Chance(threshold)
 }
 
 func generateProcessID() string {
-	return fmt.Sprintf("proc_%d", time.Now().UnixNano())
+	return randutil.NextID("proc")
 }
 
 func generateDecisionID() string {
-	return fmt.Sprintf("decision_%d", time.Now().UnixNano())
+	return randutil.NextID("decision")
 }
 
 func generateThoughtID() string {
-	return fmt.Sprintf("thought_%d", time.Now().UnixNano())
+	return randutil.NextID("thought")
 }
@@ -0,0 +1,323 @@
+package deeptreeecho
+
+import (
+	"bytes"
+	"encoding/csv"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/EchoCog/echollama/core/persistence"
+)
+
+func TestExportDecisionsJoinsAssessments(t *testing.T) {
+	mcm := NewMetaCognitiveMonitor()
+
+	assessedID := mcm.RecordDecision("choose strategy", "deliberate", "stakes were high", []string{"deliberate", "intuitive"}, 0.8)
+	unassessedID := mcm.RecordDecision("pick topic", "learning", "strongest interest", []string{"learning", "exploration"}, 0.6)
+
+	mcm.AssessDecisionQuality(assessedID, &DecisionOutcome{
+		Success:         true,
+		ActualBenefit:   0.9,
+		ExpectedBenefit: 0.8,
+	})
+
+	reports := mcm.ExportDecisions()
+	if len(reports) != 2 {
+		t.Fatalf("expected 2 reports, got %d", len(reports))
+	}
+
+	byID := make(map[string]DecisionReport)
+	for _, report := range reports {
+		byID[report.DecisionID] = report
+	}
+
+	assessed := byID[assessedID]
+	if !assessed.Assessed || assessed.OverallQuality == 0 {
+		t.Errorf("assessed decision should carry quality fields: %+v", assessed)
+	}
+	if assessed.Chosen != "deliberate" || assessed.Confidence != 0.8 {
+		t.Errorf("decision fields not flattened: %+v", assessed)
+	}
+
+	unassessed := byID[unassessedID]
+	if unassessed.Assessed || unassessed.OverallQuality != 0 {
+		t.Errorf("unassessed decision should have zeroed quality: %+v", unassessed)
+	}
+}
+
+func TestWriteDecisionsCSV(t *testing.T) {
+	mcm := NewMetaCognitiveMonitor()
+	mcm.RecordDecision("choose strategy", "deliberate", "stakes were high", nil, 0.8)
+
+	var buf bytes.Buffer
+	if err := mcm.WriteDecisionsCSV(&buf); err != nil {
+		t.Fatalf("WriteDecisionsCSV failed: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected header plus 1 row, got %d records", len(records))
+	}
+	if records[0][0] != "decision_id" {
+		t.Errorf("unexpected header: %v", records[0])
+	}
+	if records[1][3] != "deliberate" {
+		t.Errorf("chosen column wrong: %v", records[1])
+	}
+}
+
+// recursionDepths runs many meta-thought generations and tallies how
+// many thoughts exist afterwards, capturing the recursion behavior
+func recursionDepths(mcm *MetaCognitiveMonitor, runs int) []int {
+	counts := make([]int, runs)
+	for i := 0; i < runs; i++ {
+		before := len(mcm.recursiveThoughts)
+		mcm.GenerateMetaThought("decision_making", "reflecting", 0)
+		counts[i] = len(mcm.recursiveThoughts) - before
+	}
+	return counts
+}
+
+func TestRegisterStrategySelectable(t *testing.T) {
+	mcm := NewMetaCognitiveMonitor()
+
+	monteCarlo := &CognitiveStrategy{
+		ID:           "monte_carlo",
+		Name:         "Monte Carlo Sampling",
+		Description:  "Sample many candidate solutions and keep the best",
+		ApplicableTo: []ProcessType{ProcessProblemSolving},
+		Complexity:   0.6,
+		Reliability:  0.95,
+		Speed:        0.95,
+		Accuracy:     0.8,
+	}
+
+	if err := mcm.RegisterStrategy(monteCarlo); err != nil {
+		t.Fatalf("RegisterStrategy failed: %v", err)
+	}
+
+	// Duplicate IDs are rejected
+	if err := mcm.RegisterStrategy(monteCarlo); err == nil {
+		t.Error("duplicate strategy registration should fail")
+	}
+
+	// With speed required, monte_carlo outscores deliberate (0.3) and
+	// analytical (0.5) for problem solving
+	selected := mcm.SelectStrategy(ProcessProblemSolving, map[string]interface{}{
+		"speed_required": true,
+	})
+	if selected == nil || selected.ID != "monte_carlo" {
+		t.Fatalf("expected monte_carlo to win on speed, got %+v", selected)
+	}
+
+	// Metrics were initialized so outcome recording works immediately
+	mcm.RecordStrategyOutcome("monte_carlo", true, 0.9, time.Second)
+	mcm.mu.RLock()
+	metrics := mcm.strategyPerformance["monte_carlo"]
+	mcm.mu.RUnlock()
+	if metrics == nil || metrics.TimesUsed != 1 {
+		t.Error("registered strategy should track outcomes")
+	}
+
+	mcm.UnregisterStrategy("monte_carlo")
+	selected = mcm.SelectStrategy(ProcessProblemSolving, map[string]interface{}{
+		"speed_required": true,
+	})
+	if selected != nil && selected.ID == "monte_carlo" {
+		t.Error("unregistered strategy should no longer be selectable")
+	}
+}
+
+func TestStaleProcessesAreReaped(t *testing.T) {
+	mcm := NewMetaCognitiveMonitor()
+	mcm.SetProcessTimeout(20 * time.Millisecond)
+	mcm.reapInterval = 10 * time.Millisecond
+
+	if err := mcm.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer mcm.Stop()
+
+	processID := mcm.StartProcess("stuck reasoning", ProcessReasoning)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		mcm.mu.RLock()
+		_, active := mcm.activeProcesses[processID]
+		mcm.mu.RUnlock()
+		if !active {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+
+	if _, active := mcm.activeProcesses[processID]; active {
+		t.Fatal("stale process should have been reaped")
+	}
+
+	var record *ProcessRecord
+	for _, r := range mcm.processHistory {
+		if r.ProcessID == processID {
+			record = r
+			break
+		}
+	}
+	if record == nil {
+		t.Fatal("reaped process should be recorded in history")
+	}
+	if record.Success {
+		t.Error("aborted process must not count as a success")
+	}
+}
+
+func TestReapHonorsEstimatedDuration(t *testing.T) {
+	mcm := NewMetaCognitiveMonitor()
+
+	processID := mcm.StartProcess("quick estimate", ProcessThinking)
+	mcm.mu.Lock()
+	mcm.activeProcesses[processID].EstimatedDuration = 1 * time.Millisecond
+	mcm.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	if reaped := mcm.ReapStaleProcesses(); reaped != 1 {
+		t.Errorf("expected 1 process reaped via estimated duration, got %d", reaped)
+	}
+}
+
+func TestRegisterStrategyValidation(t *testing.T) {
+	mcm := NewMetaCognitiveMonitor()
+
+	if err := mcm.RegisterStrategy(nil); err == nil {
+		t.Error("nil strategy should be rejected")
+	}
+	if err := mcm.RegisterStrategy(&CognitiveStrategy{Name: "anonymous"}); err == nil {
+		t.Error("strategy without ID should be rejected")
+	}
+	if err := mcm.RegisterStrategy(&CognitiveStrategy{ID: "narrow"}); err == nil {
+		t.Error("strategy without applicable process types should be rejected")
+	}
+}
+
+func TestSeededRecursionIsDeterministic(t *testing.T) {
+	first := recursionDepths(NewMetaCognitiveMonitorWithSeed(42), 50)
+	second := recursionDepths(NewMetaCognitiveMonitorWithSeed(42), 50)
+
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("run %d diverged under the same seed: %d vs %d", i, first[i], second[i])
+		}
+	}
+}
+
+func TestSeededRecursionDepthDistribution(t *testing.T) {
+	counts := recursionDepths(NewMetaCognitiveMonitorWithSeed(7), 200)
+
+	recursed := 0
+	total := 0
+	for _, count := range counts {
+		if count < 1 {
+			t.Fatal("each generation should record at least the root thought")
+		}
+		if count > 1 {
+			recursed++
+		}
+		total += count
+		// Depth is capped, so a single generation can never exceed the
+		// maximum reasoning depth
+		if count > 5 {
+			t.Fatalf("recursion exceeded max depth: %d thoughts in one generation", count)
+		}
+	}
+
+	// At depth 0 the recursion probability is 0.7, so over 200 runs a
+	// fixed seed must recurse often but not always
+	if recursed < 100 || recursed == len(counts) {
+		t.Errorf("unexpected recursion rate: %d of %d runs recursed", recursed, len(counts))
+	}
+}
+
+func TestMetaThoughtTreeRoundTripsThroughStore(t *testing.T) {
+	store, err := persistence.NewSQLiteStore(filepath.Join(t.TempDir(), "meta.db"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	mcm := NewMetaCognitiveMonitor()
+
+	// Build a depth-3 recursion deterministically
+	root := &MetaThought{ID: "mt-root", Depth: 0, About: "task_processing", Content: "processed the task", Timestamp: time.Now()}
+	child := &MetaThought{ID: "mt-child", Depth: 1, About: "meta-reflection", Content: "thinking about processing", Insight: "first-order insight", Timestamp: time.Now(), ParentThought: root}
+	grandchild := &MetaThought{ID: "mt-grandchild", Depth: 2, About: "meta-reflection", Content: "thinking about thinking", Insight: "second-order insight", Timestamp: time.Now(), ParentThought: child}
+
+	mcm.mu.Lock()
+	mcm.recursiveThoughts = append(mcm.recursiveThoughts, root, child, grandchild)
+	mcm.mu.Unlock()
+
+	if err := mcm.SaveMetaThoughts(store); err != nil {
+		t.Fatalf("SaveMetaThoughts failed: %v", err)
+	}
+
+	// A fresh monitor pointed at the same store should rebuild the tree
+	restarted := NewMetaCognitiveMonitor()
+	if err := restarted.LoadMetaThoughtTree(store); err != nil {
+		t.Fatalf("LoadMetaThoughtTree failed: %v", err)
+	}
+
+	tree := restarted.GetThoughtTree("mt-root")
+	if tree == nil {
+		t.Fatal("expected the root thought to be reconstructable")
+	}
+	if tree.ParentThought != nil {
+		t.Error("root should have no parent")
+	}
+	if len(tree.Children) != 1 || tree.Children[0].ID != "mt-child" {
+		t.Fatalf("root should have exactly the persisted child: %+v", tree.Children)
+	}
+
+	rebuilt := tree.Children[0]
+	if rebuilt.ParentThought == nil || rebuilt.ParentThought.ID != "mt-root" {
+		t.Error("child's parent pointer should be relinked")
+	}
+	if rebuilt.Insight != "first-order insight" {
+		t.Errorf("child fields not restored: %+v", rebuilt)
+	}
+	if len(rebuilt.Children) != 1 || rebuilt.Children[0].ID != "mt-grandchild" {
+		t.Fatalf("grandchild missing from rebuilt tree: %+v", rebuilt.Children)
+	}
+	if rebuilt.Children[0].Depth != 2 || rebuilt.Children[0].ParentThought.ID != "mt-child" {
+		t.Error("grandchild structure wrong after round trip")
+	}
+}
+
+func TestGetThoughtTreeUnknownRoot(t *testing.T) {
+	mcm := NewMetaCognitiveMonitor()
+
+	if tree := mcm.GetThoughtTree("missing"); tree != nil {
+		t.Errorf("unknown root should yield nil, got %+v", tree)
+	}
+}
+
+func TestGetThoughtTreeReturnsDetachedCopy(t *testing.T) {
+	mcm := NewMetaCognitiveMonitor()
+
+	root := &MetaThought{ID: "mt-1", About: "state", Content: "original", Timestamp: time.Now()}
+	mcm.mu.Lock()
+	mcm.recursiveThoughts = append(mcm.recursiveThoughts, root)
+	mcm.mu.Unlock()
+
+	tree := mcm.GetThoughtTree("mt-1")
+	tree.Content = "tampered"
+
+	if fresh := mcm.GetThoughtTree("mt-1"); fresh.Content != "original" {
+		t.Error("GetThoughtTree must return detached copies")
+	}
+}
@@ -78,7 +78,10 @@ func (m *MultiProviderLLM) initializeProviders() {
 	}
 	
 	if len(m.providers) == 0 {
-		fmt.Println("⚠️  No LLM providers available - check API keys")
+		// Fall back to the deterministic echo provider so the agent can
+		// keep running in a reduced-capability mode without any API key
+		m.AddProvider(NewEchoProvider())
+		fmt.Println("⚠️  No API-backed LLM providers available - running in degraded mode with Echo fallback")
 	}
 }
 
@@ -292,6 +295,20 @@ func (m *MultiProviderLLM) GetAvailableProviders() []string {
 	return available
 }
 
+// IsDegraded returns true when only the deterministic Echo fallback is
+// available, i.e. no API-backed provider is configured
+func (m *MultiProviderLLM) IsDegraded() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, provider := range m.providers {
+		if provider.IsAvailable() && provider.GetName() != "Echo" {
+			return false
+		}
+	}
+	return true
+}
+
 // IsAvailable returns true if at least one provider is available
 func (m *MultiProviderLLM) IsAvailable() bool {
 	m.mu.RLock()
@@ -0,0 +1,58 @@
+package deeptreeecho
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExplainLastProcess(t *testing.T) {
+	n5 := NewN5CognitiveSystem()
+
+	if _, err := n5.ExplainLastProcess(); err == nil {
+		t.Error("expected an error before any process has run")
+	}
+
+	result := n5.ProcessWithMetaCognition("plan a learning strategy for pattern analysis and growth")
+	if !result.Success {
+		t.Fatal("processing should succeed")
+	}
+
+	explanation, err := n5.ExplainLastProcess()
+	if err != nil {
+		t.Fatalf("ExplainLastProcess failed: %v", err)
+	}
+
+	if !strings.Contains(explanation, result.StrategyUsed) {
+		t.Errorf("explanation should reference the strategy %q:\n%s", result.StrategyUsed, explanation)
+	}
+
+	if len(result.ReasoningSteps) == 0 {
+		t.Fatal("expected reasoning steps to be recorded")
+	}
+	if !strings.Contains(explanation, result.ReasoningSteps[0]) {
+		t.Errorf("explanation should include a reasoning step:\n%s", explanation)
+	}
+
+	if len(result.WisdomContents) == 0 {
+		t.Fatal("expected wisdom to be applied")
+	}
+	if !strings.Contains(explanation, result.WisdomContents[0]) {
+		t.Errorf("explanation should include the applied wisdom:\n%s", explanation)
+	}
+}
+
+func TestExplainLastProcessWithEmotion(t *testing.T) {
+	n5 := NewN5CognitiveSystem()
+	n5.AttachEmotionSystem(NewEmotionSystem())
+
+	n5.ProcessWithMetaCognition("reflect on recent discoveries about adaptation")
+
+	explanation, err := n5.ExplainLastProcess()
+	if err != nil {
+		t.Fatalf("ExplainLastProcess failed: %v", err)
+	}
+
+	if !strings.Contains(explanation, "Emotionally") {
+		t.Errorf("explanation should include the emotional context:\n%s", explanation)
+	}
+}
@@ -3,9 +3,10 @@ package deeptreeecho
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
-	
+
 	"github.com/EchoCog/echollama/core/echobeats"
 )
 
@@ -20,10 +21,14 @@ type N5CognitiveSystem struct {
 	metacognitiveMonitor    *MetaCognitiveMonitor
 	advancedReasoning       *AdvancedReasoningEngine
 	wisdomApplication       *WisdomApplicationEngine
-	
+
+	// Optional emotional context for explanations
+	emotions                *EmotionSystem
+
 	// Integration state
 	running                 bool
 	lastUpdate              time.Time
+	lastResult              *MetaCognitiveResult
 	
 	// Metrics
 	totalOperations         uint64
@@ -99,24 +104,30 @@ func (n5 *N5CognitiveSystem) ProcessWithMetaCognition(task string) *MetaCognitiv
 	// 3. Find relevant wisdom
 	wisdomMatches := n5.wisdomApplication.FindRelevantWisdom(task, 3)
 	result.WisdomApplied = len(wisdomMatches)
-	
+	for _, match := range wisdomMatches {
+		result.WisdomContents = append(result.WisdomContents, match.Wisdom.Content)
+	}
+
 	// 4. Perform advanced reasoning
 	chainID := n5.advancedReasoning.StartReasoningChain(fmt.Sprintf("Solve: %s", task))
 	
 	// Add reasoning steps
-	n5.advancedReasoning.AddReasoningStep(chainID, StepDeduction, 
-		"Task requires systematic approach", 
+	n5.advancedReasoning.AddReasoningStep(chainID, StepDeduction,
+		"Task requires systematic approach",
 		"Breaking down into components",
 		"Identified sub-problems", 0.8)
-	
+	result.ReasoningSteps = append(result.ReasoningSteps, "Breaking down into components -> Identified sub-problems")
+
 	n5.advancedReasoning.AddReasoningStep(chainID, StepAbduction,
 		"Need to find best solution",
 		"Exploring alternatives",
 		"Generated candidate solutions", 0.7)
-	
+	result.ReasoningSteps = append(result.ReasoningSteps, "Exploring alternatives -> Generated candidate solutions")
+
 	// Complete reasoning
 	conclusion := fmt.Sprintf("Completed analysis of '%s' using %s strategy", task, strategy.Name)
 	n5.advancedReasoning.CompleteReasoningChain(chainID, conclusion, 0.75)
+	result.Conclusion = conclusion
 	
 	// 5. Update process status
 	n5.metacognitiveMonitor.UpdateProcess(processID, 1.0, StatusCompleted)
@@ -131,10 +142,69 @@ func (n5 *N5CognitiveSystem) ProcessWithMetaCognition(task string) *MetaCognitiv
 	
 	result.EndTime = time.Now()
 	result.Success = true
-	
+
+	n5.mu.Lock()
+	n5.lastResult = result
+	n5.mu.Unlock()
+
 	return result
 }
 
+// AttachEmotionSystem provides emotional context for explanations
+func (n5 *N5CognitiveSystem) AttachEmotionSystem(emotions *EmotionSystem) {
+	n5.mu.Lock()
+	defer n5.mu.Unlock()
+	n5.emotions = emotions
+}
+
+// ExplainLastProcess stitches the most recent meta-cognitive result into a
+// human-readable "thinking out loud" narrative covering the reasoning
+// chain, the wisdom applied, and the current emotional context
+func (n5 *N5CognitiveSystem) ExplainLastProcess() (string, error) {
+	n5.mu.RLock()
+	result := n5.lastResult
+	emotions := n5.emotions
+	n5.mu.RUnlock()
+
+	if result == nil {
+		return "", fmt.Errorf("no process has been run yet")
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Thinking out loud about %q:\n", result.Task)
+	fmt.Fprintf(&sb, "I approached this with the %s strategy.\n", result.StrategyUsed)
+
+	if len(result.ReasoningSteps) > 0 {
+		sb.WriteString("My reasoning went through these steps:\n")
+		for i, step := range result.ReasoningSteps {
+			fmt.Fprintf(&sb, "  %d. %s\n", i+1, step)
+		}
+	}
+
+	if len(result.WisdomContents) > 0 {
+		sb.WriteString("I drew on this wisdom:\n")
+		for _, wisdom := range result.WisdomContents {
+			fmt.Fprintf(&sb, "  - %s\n", wisdom)
+		}
+	}
+
+	if result.Conclusion != "" {
+		fmt.Fprintf(&sb, "Conclusion: %s\n", result.Conclusion)
+	}
+
+	if result.MetaInsight != "" {
+		fmt.Fprintf(&sb, "On reflection: %s\n", result.MetaInsight)
+	}
+
+	if emotions != nil {
+		fmt.Fprintf(&sb, "Emotionally, I feel %s (valence %.2f, arousal %.2f).\n",
+			emotions.GetDominantEmotion(), emotions.GetValence(), emotions.GetArousal())
+	}
+
+	return sb.String(), nil
+}
+
 // LearnFromEngagement updates interest patterns based on engagement
 func (n5 *N5CognitiveSystem) LearnFromEngagement(topic string, duration time.Duration, satisfaction float64) {
 	n5.mu.RLock()
@@ -301,13 +371,16 @@ func (n5 *N5CognitiveSystem) autonomousCognitiveCycle() {
 
 // MetaCognitiveResult represents the result of meta-cognitive processing
 type MetaCognitiveResult struct {
-	Task          string
-	StartTime     time.Time
-	EndTime       time.Time
-	StrategyUsed  string
-	WisdomApplied int
-	MetaInsight   string
-	Success       bool
+	Task           string
+	StartTime      time.Time
+	EndTime        time.Time
+	StrategyUsed   string
+	WisdomApplied  int
+	WisdomContents []string
+	ReasoningSteps []string
+	Conclusion     string
+	MetaInsight    string
+	Success        bool
 }
 
 // ReasoningResult represents advanced reasoning output
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 )
 
@@ -61,19 +63,43 @@ type OpenAIError struct {
 	Code    string `json:"code"`
 }
 
-// NewOpenAIProvider creates a new OpenAI provider
-func NewOpenAIProvider(apiKey, model string) *OpenAIProvider {
+// OpenAIProviderOption customizes an OpenAIProvider
+type OpenAIProviderOption func(*OpenAIProvider)
+
+// WithBaseURL points the provider at an OpenAI-compatible endpoint such
+// as a local Ollama server (e.g. http://localhost:11434/v1)
+func WithBaseURL(baseURL string) OpenAIProviderOption {
+	return func(p *OpenAIProvider) {
+		p.baseURL = strings.TrimSuffix(baseURL, "/") + "/chat/completions"
+	}
+}
+
+// NewOpenAIProvider creates a new OpenAI provider. The base URL defaults
+// to the OpenAI API but can be overridden via OPENAI_BASE_URL or
+// WithBaseURL for OpenAI-compatible gateways.
+func NewOpenAIProvider(apiKey, model string, opts ...OpenAIProviderOption) *OpenAIProvider {
 	if apiKey == "" {
 		return nil
 	}
-	
-	return &OpenAIProvider{
+
+	baseURL := os.Getenv("OPENAI_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://api.openai.com/v1"
+	}
+
+	provider := &OpenAIProvider{
 		apiKey:    apiKey,
 		model:     model,
-		baseURL:   "https://api.openai.com/v1/chat/completions",
+		baseURL:   strings.TrimSuffix(baseURL, "/") + "/chat/completions",
 		client:    &http.Client{Timeout: 30 * time.Second},
 		available: true,
 	}
+
+	for _, opt := range opts {
+		opt(provider)
+	}
+
+	return provider
 }
 
 // GenerateThought generates a thought using OpenAI
@@ -0,0 +1,50 @@
+package deeptreeecho
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOpenAIProviderCustomBaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"choices":[{"message":{"role":"assistant","content":"local thought"}}]}`)
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider("test-key", "llama3", WithBaseURL(server.URL+"/v1"))
+	if provider == nil {
+		t.Fatal("provider should be constructed")
+	}
+
+	thought, err := provider.GenerateThought(context.Background(), "think")
+	if err != nil {
+		t.Fatalf("GenerateThought failed: %v", err)
+	}
+	if thought != "local thought" {
+		t.Errorf("unexpected thought: %q", thought)
+	}
+}
+
+func TestOpenAIProviderBaseURLFromEnv(t *testing.T) {
+	t.Setenv("OPENAI_BASE_URL", "http://localhost:11434/v1/")
+
+	provider := NewOpenAIProvider("test-key", "llama3")
+	if provider.baseURL != "http://localhost:11434/v1/chat/completions" {
+		t.Errorf("env base URL not applied: %s", provider.baseURL)
+	}
+}
+
+func TestOpenAIProviderDefaultBaseURL(t *testing.T) {
+	t.Setenv("OPENAI_BASE_URL", "")
+
+	provider := NewOpenAIProvider("test-key", "gpt-4.1-mini")
+	if provider.baseURL != "https://api.openai.com/v1/chat/completions" {
+		t.Errorf("default base URL wrong: %s", provider.baseURL)
+	}
+}
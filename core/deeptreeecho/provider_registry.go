@@ -0,0 +1,166 @@
+package deeptreeecho
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Provider is the unified interface for LLM backends using the
+// LLMRequest/LLMResponse shapes, regardless of the underlying API
+type Provider interface {
+	Generate(ctx context.Context, req LLMRequest) (*LLMResponse, error)
+	Embed(ctx context.Context, texts []string) ([][]float64, error)
+	GetModelInfo() ModelInfo
+}
+
+// ModelInfo describes the backend a Provider talks to
+type ModelInfo struct {
+	Provider  string
+	Model     string
+	MaxTokens int
+}
+
+// GetModelInfo returns the backend the unified client is configured for
+func (c *LLMClient) GetModelInfo() ModelInfo {
+	maxTokens := 4096
+	if c.provider == "openai" || c.provider == "openrouter" {
+		maxTokens = openAIContextBudget(c.model)
+	}
+
+	return ModelInfo{
+		Provider:  c.provider,
+		Model:     c.model,
+		MaxTokens: maxTokens,
+	}
+}
+
+// LLMProviderAdapter wraps a legacy LLMProvider (GenerateThought-style)
+// so it can participate in the unified Provider registry
+type LLMProviderAdapter struct {
+	provider LLMProvider
+}
+
+// NewLLMProviderAdapter adapts a legacy provider to the Provider interface
+func NewLLMProviderAdapter(provider LLMProvider) *LLMProviderAdapter {
+	return &LLMProviderAdapter{provider: provider}
+}
+
+// Generate maps the unified request onto the legacy thought interface
+func (a *LLMProviderAdapter) Generate(ctx context.Context, req LLMRequest) (*LLMResponse, error) {
+	if !a.provider.IsAvailable() {
+		return nil, fmt.Errorf("provider %s not available", a.provider.GetName())
+	}
+
+	prompt := req.UserPrompt
+	if req.SystemPrompt != "" {
+		prompt = req.SystemPrompt + "\n\n" + req.UserPrompt
+	}
+
+	content, err := a.provider.GenerateThought(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LLMResponse{Content: content}, nil
+}
+
+// Embed is unsupported for legacy thought-style providers
+func (a *LLMProviderAdapter) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	return nil, fmt.Errorf("provider %s does not support embeddings", a.provider.GetName())
+}
+
+// GetModelInfo reports the wrapped provider's identity
+func (a *LLMProviderAdapter) GetModelInfo() ModelInfo {
+	return ModelInfo{
+		Provider:  a.provider.GetName(),
+		MaxTokens: 4096,
+	}
+}
+
+// ProviderRegistry holds named providers and tries them in a configurable
+// fallback order
+type ProviderRegistry struct {
+	mu            sync.RWMutex
+	providers     map[string]Provider
+	fallbackOrder []string
+}
+
+// NewProviderRegistry creates an empty provider registry
+func NewProviderRegistry() *ProviderRegistry {
+	return &ProviderRegistry{
+		providers:     make(map[string]Provider),
+		fallbackOrder: make([]string, 0),
+	}
+}
+
+// Register adds a named provider; registration order is the default
+// fallback order
+func (r *ProviderRegistry) Register(name string, provider Provider) error {
+	if provider == nil {
+		return fmt.Errorf("cannot register nil provider")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.providers[name]; exists {
+		return fmt.Errorf("provider %s already registered", name)
+	}
+
+	r.providers[name] = provider
+	r.fallbackOrder = append(r.fallbackOrder, name)
+
+	return nil
+}
+
+// SetFallbackOrder overrides the order in which providers are tried
+func (r *ProviderRegistry) SetFallbackOrder(order []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, name := range order {
+		if _, exists := r.providers[name]; !exists {
+			return fmt.Errorf("provider %s not registered", name)
+		}
+	}
+
+	r.fallbackOrder = append([]string(nil), order...)
+
+	return nil
+}
+
+// GetProvider returns a registered provider by name
+func (r *ProviderRegistry) GetProvider(name string) (Provider, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, exists := r.providers[name]
+	return provider, exists
+}
+
+// Generate tries each provider in fallback order until one succeeds
+func (r *ProviderRegistry) Generate(ctx context.Context, req LLMRequest) (*LLMResponse, error) {
+	r.mu.RLock()
+	order := append([]string(nil), r.fallbackOrder...)
+	providers := make(map[string]Provider, len(r.providers))
+	for name, provider := range r.providers {
+		providers[name] = provider
+	}
+	r.mu.RUnlock()
+
+	if len(order) == 0 {
+		return nil, fmt.Errorf("no providers registered")
+	}
+
+	var lastErr error
+	for _, name := range order {
+		response, err := providers[name].Generate(ctx, req)
+		if err == nil {
+			return response, nil
+		}
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("all providers failed, last error: %w", lastErr)
+}
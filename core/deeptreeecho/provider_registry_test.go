@@ -0,0 +1,117 @@
+package deeptreeecho
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// stubProvider implements Provider with a canned response or error
+type stubProvider struct {
+	name     string
+	response string
+	err      error
+	calls    int
+}
+
+func (s *stubProvider) Generate(ctx context.Context, req LLMRequest) (*LLMResponse, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &LLMResponse{Content: s.response, Model: s.name}, nil
+}
+
+func (s *stubProvider) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	return nil, fmt.Errorf("provider %s does not support embeddings", s.name)
+}
+
+func (s *stubProvider) GetModelInfo() ModelInfo {
+	return ModelInfo{Provider: s.name}
+}
+
+func TestRegistryFallsBackWhenPrimaryErrors(t *testing.T) {
+	registry := NewProviderRegistry()
+
+	primary := &stubProvider{name: "anthropic", err: fmt.Errorf("rate limited")}
+	secondary := &stubProvider{name: "openai", response: "fallback answer"}
+
+	if err := registry.Register("anthropic", primary); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if err := registry.Register("openai", secondary); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	response, err := registry.Generate(context.Background(), LLMRequest{UserPrompt: "think"})
+	if err != nil {
+		t.Fatalf("Generate should fall back: %v", err)
+	}
+	if response.Content != "fallback answer" {
+		t.Errorf("unexpected response: %q", response.Content)
+	}
+	if primary.calls != 1 || secondary.calls != 1 {
+		t.Errorf("expected both providers tried once: primary=%d secondary=%d", primary.calls, secondary.calls)
+	}
+}
+
+func TestRegistryHonorsFallbackOrder(t *testing.T) {
+	registry := NewProviderRegistry()
+
+	first := &stubProvider{name: "openai", response: "from openai"}
+	second := &stubProvider{name: "local", response: "from local"}
+	registry.Register("openai", first)
+	registry.Register("local", second)
+
+	if err := registry.SetFallbackOrder([]string{"local", "openai"}); err != nil {
+		t.Fatalf("SetFallbackOrder failed: %v", err)
+	}
+
+	response, err := registry.Generate(context.Background(), LLMRequest{UserPrompt: "think"})
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if response.Content != "from local" {
+		t.Errorf("fallback order not honored: %q", response.Content)
+	}
+
+	if err := registry.SetFallbackOrder([]string{"unknown"}); err == nil {
+		t.Error("unknown provider in order should be rejected")
+	}
+}
+
+func TestRegistryErrorsWhenAllFail(t *testing.T) {
+	registry := NewProviderRegistry()
+
+	if _, err := registry.Generate(context.Background(), LLMRequest{}); err == nil {
+		t.Error("empty registry should error")
+	}
+
+	registry.Register("broken", &stubProvider{name: "broken", err: fmt.Errorf("down")})
+	if _, err := registry.Generate(context.Background(), LLMRequest{}); err == nil {
+		t.Error("registry with only failing providers should error")
+	}
+
+	if err := registry.Register("broken", &stubProvider{name: "broken"}); err == nil {
+		t.Error("duplicate registration should be rejected")
+	}
+}
+
+func TestLLMProviderAdapterBridgesLegacyInterface(t *testing.T) {
+	adapter := NewLLMProviderAdapter(NewEchoProvider())
+
+	response, err := adapter.Generate(context.Background(), LLMRequest{
+		SystemPrompt: "you are an echo",
+		UserPrompt:   "hello",
+	})
+	if err != nil {
+		t.Fatalf("adapter Generate failed: %v", err)
+	}
+	if response.Content == "" {
+		t.Error("adapter should return content from the legacy provider")
+	}
+
+	if adapter.GetModelInfo().Provider == "" {
+		t.Error("adapter should report the wrapped provider name")
+	}
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
+
+	"github.com/EchoCog/echollama/core/persistence"
 )
 
 // WisdomApplicationEngine refines how wisdom is matched and applied to contexts
@@ -21,7 +26,11 @@ type WisdomApplicationEngine struct {
 	// Context-wisdom matching
 	contextMatcher          *ContextMatcher
 	relevanceCache          map[string]*RelevanceScore
-	
+
+	// Optional semantic matching; nil falls back to keyword heuristics
+	embedder                EmbeddingProvider
+	embeddingCache          map[string][]float64
+
 	// Wisdom synthesis
 	synthesizer             *WisdomSynthesizer
 	synthesizedWisdom       []*WisdomEntry
@@ -34,11 +43,23 @@ type WisdomApplicationEngine struct {
 	totalApplications       uint64
 	successfulApplications  uint64
 	totalSyntheses          uint64
-	
+
+	// Decay
+	pruneInterval           time.Duration
+
 	// Running state
 	running                 bool
 }
 
+// Wisdom decay parameters: entries idle past the threshold lose
+// usefulness, and synthesized entries below the floor are deleted
+const (
+	defaultPruneInterval     = 1 * time.Hour
+	wisdomIdleThreshold      = 7 * 24 * time.Hour
+	wisdomUsefulnessDecay    = 0.95
+	wisdomUsefulnessFloor    = 0.2
+)
+
 // WisdomEntry represents a piece of wisdom
 type WisdomEntry struct {
 	ID              string
@@ -59,6 +80,12 @@ type WisdomEntry struct {
 	Metadata        map[string]interface{}
 }
 
+// EmbeddingProvider supplies vector embeddings for semantic matching,
+// typically backed by an LLM provider's embedding endpoint
+type EmbeddingProvider interface {
+	Embed(text string) ([]float64, error)
+}
+
 // ContextMatcher matches contexts to relevant wisdom
 type ContextMatcher struct {
 	mu                      sync.RWMutex
@@ -71,11 +98,21 @@ type ContextMatcher struct {
 	exactMatchWeight        float64
 	semanticMatchWeight     float64
 	analogyMatchWeight      float64
-	
+
 	// Cache
-	matchCache              map[string][]*WisdomMatch
+	matchCache              map[string]*cachedMatch
+	cacheTTL                time.Duration
 }
 
+// cachedMatch is a matchCache entry stamped for TTL expiry
+type cachedMatch struct {
+	matches  []*WisdomMatch
+	cachedAt time.Time
+}
+
+// defaultMatchCacheTTL bounds how long match results stay fresh
+const defaultMatchCacheTTL = 5 * time.Minute
+
 // ContextPattern represents recurring context patterns
 type ContextPattern struct {
 	ID              string
@@ -254,6 +291,8 @@ func NewWisdomApplicationEngine() *WisdomApplicationEngine {
 		wisdomBase:         make(map[string]*WisdomEntry),
 		wisdomCategories:   make(map[string][]*WisdomEntry),
 		relevanceCache:     make(map[string]*RelevanceScore),
+		embeddingCache:     make(map[string][]float64),
+		pruneInterval:      defaultPruneInterval,
 		applicationHistory: make([]*WisdomApplication, 0),
 		synthesizedWisdom:  make([]*WisdomEntry, 0),
 		contextMatcher:     newContextMatcher(),
@@ -270,26 +309,37 @@ func NewWisdomApplicationEngine() *WisdomApplicationEngine {
 // FindRelevantWisdom matches wisdom to a given context
 func (wae *WisdomApplicationEngine) FindRelevantWisdom(context string, topK int) []*WisdomMatch {
 	wae.mu.RLock()
-	
-	// Check cache
+
+	// Check cache, treating expired entries as misses
 	cacheKey := fmt.Sprintf("%s_%d", context, topK)
 	if cached, exists := wae.contextMatcher.matchCache[cacheKey]; exists {
-		wae.mu.RUnlock()
-		return cached
+		if time.Since(cached.cachedAt) < wae.contextMatcher.cacheTTL {
+			wae.mu.RUnlock()
+			return cached.matches
+		}
 	}
 	wae.mu.RUnlock()
-	
+
 	wae.mu.Lock()
 	defer wae.mu.Unlock()
-	
+
+	// Re-check under the write lock: another goroutine may have
+	// computed this entry while we waited, and recomputing over the
+	// whole wisdom base is the expensive path
+	if cached, exists := wae.contextMatcher.matchCache[cacheKey]; exists {
+		if time.Since(cached.cachedAt) < wae.contextMatcher.cacheTTL {
+			return cached.matches
+		}
+	}
+
 	// Find matches
 	matches := make([]*WisdomMatch, 0)
 	
 	for _, wisdom := range wae.wisdomBase {
 		relevance := wae.calculateRelevance(context, wisdom)
-		
+
 		if relevance.Overall > 0.3 {
-			matchType := determineMatchType(context, wisdom)
+			matchType := wae.determineMatchTypeFor(context, wisdom)
 			match := &WisdomMatch{
 				WisdomID:       wisdom.ID,
 				Wisdom:         wisdom,
@@ -311,11 +361,145 @@ func (wae *WisdomApplicationEngine) FindRelevantWisdom(context string, topK int)
 	}
 	
 	// Cache results
-	wae.contextMatcher.matchCache[cacheKey] = matches
-	
+	wae.contextMatcher.matchCache[cacheKey] = &cachedMatch{
+		matches:  matches,
+		cachedAt: time.Now(),
+	}
+
 	return matches
 }
 
+// SetEmbeddingProvider wires an embedding backend for semantic
+// matching. Cached matches and embeddings are dropped, since relevance
+// is now computed differently.
+func (wae *WisdomApplicationEngine) SetEmbeddingProvider(provider EmbeddingProvider) {
+	wae.mu.Lock()
+	defer wae.mu.Unlock()
+
+	wae.embedder = provider
+	wae.embeddingCache = make(map[string][]float64)
+	wae.invalidateMatchCache()
+}
+
+// embeddingFor returns a cached embedding for text, computing it on
+// first use; caller must hold the lock. Returns false when no provider
+// is configured or the provider fails.
+func (wae *WisdomApplicationEngine) embeddingFor(text string) ([]float64, bool) {
+	if wae.embedder == nil {
+		return nil, false
+	}
+
+	if vector, exists := wae.embeddingCache[text]; exists {
+		return vector, vector != nil
+	}
+
+	vector, err := wae.embedder.Embed(text)
+	if err != nil {
+		// Cache the failure so a flaky provider isn't hammered per match
+		wae.embeddingCache[text] = nil
+		return nil, false
+	}
+
+	wae.embeddingCache[text] = vector
+	return vector, true
+}
+
+// semanticSimilarity computes cosine similarity between the context and
+// a wisdom entry's embedding, clamped to [0, 1]; caller must hold the
+// lock. The second return is false when embeddings are unavailable.
+func (wae *WisdomApplicationEngine) semanticSimilarity(context string, wisdom *WisdomEntry) (float64, bool) {
+	contextVector, ok := wae.embeddingFor(context)
+	if !ok {
+		return 0, false
+	}
+
+	wisdomVector, ok := wae.embeddingFor(wisdomEmbeddingText(wisdom))
+	if !ok {
+		return 0, false
+	}
+
+	similarity := cosineSimilarity(contextVector, wisdomVector)
+	return math.Max(0.0, math.Min(1.0, similarity)), true
+}
+
+// wisdomEmbeddingText flattens the fields that carry a wisdom entry's
+// meaning into one embeddable string
+func wisdomEmbeddingText(wisdom *WisdomEntry) string {
+	parts := []string{wisdom.Content}
+	parts = append(parts, wisdom.Applicability...)
+	parts = append(parts, wisdom.Tags...)
+	return strings.Join(parts, " ")
+}
+
+// cosineSimilarity computes the cosine of the angle between two vectors
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// determineMatchTypeFor classifies a match semantically when an
+// embedder is available, falling back to the heuristic otherwise;
+// caller must hold the lock
+func (wae *WisdomApplicationEngine) determineMatchTypeFor(context string, wisdom *WisdomEntry) MatchType {
+	if similarity, ok := wae.semanticSimilarity(context, wisdom); ok {
+		switch {
+		case similarity > 0.9:
+			return MatchExact
+		case similarity > 0.65:
+			return MatchSemantic
+		default:
+			return MatchAnalogy
+		}
+	}
+
+	return determineMatchType(context, wisdom)
+}
+
+// SetMatchCacheTTL overrides how long cached match results stay fresh
+func (wae *WisdomApplicationEngine) SetMatchCacheTTL(ttl time.Duration) {
+	wae.mu.Lock()
+	defer wae.mu.Unlock()
+	wae.contextMatcher.cacheTTL = ttl
+}
+
+// invalidateMatchCache drops all cached matches; caller must hold the
+// lock. Used whenever relevance inputs change.
+func (wae *WisdomApplicationEngine) invalidateMatchCache() {
+	wae.contextMatcher.matchCache = make(map[string]*cachedMatch)
+}
+
+// RecommendWisdomForGoals matches wisdom against each goal, using the
+// goal's description and type as the matching context. The result maps
+// goal IDs to their top-K wisdom matches, operationalizing wisdom in
+// service of active goals.
+func (wae *WisdomApplicationEngine) RecommendWisdomForGoals(goals []*OrchGoal, topK int) map[string][]*WisdomMatch {
+	recommendations := make(map[string][]*WisdomMatch, len(goals))
+
+	for _, goal := range goals {
+		if goal == nil {
+			continue
+		}
+		goalContext := fmt.Sprintf("%s (%s)", goal.Description, goal.Type.String())
+		recommendations[goal.ID] = wae.FindRelevantWisdom(goalContext, topK)
+	}
+
+	return recommendations
+}
+
 // ApplyWisdom applies wisdom to a context and records the application
 func (wae *WisdomApplicationEngine) ApplyWisdom(wisdomID, context string) *WisdomApplication {
 	wae.mu.Lock()
@@ -360,7 +544,10 @@ func (wae *WisdomApplicationEngine) ApplyWisdom(wisdomID, context string) *Wisdo
 	
 	wae.applicationHistory = append(wae.applicationHistory, application)
 	wae.totalApplications++
-	
+
+	// Success rates feed relevance, so cached matches are stale now
+	wae.invalidateMatchCache()
+
 	return application
 }
 
@@ -416,7 +603,10 @@ func (wae *WisdomApplicationEngine) SynthesizeWisdom(sourceIDs []string) *Wisdom
 		Quality:           0.7,
 		Timestamp:         time.Now(),
 	})
-	
+
+	// New wisdom must be able to surface for previously seen contexts
+	wae.invalidateMatchCache()
+
 	return synthesized
 }
 
@@ -433,9 +623,12 @@ func (wae *WisdomApplicationEngine) ProvideFeedback(applicationID string, rating
 	}
 	
 	wae.feedbackLoop.feedbackEntries = append(wae.feedbackLoop.feedbackEntries, feedback)
-	
+
 	// Learn from feedback
 	wae.processFeedback(feedback)
+
+	// Feedback adjusts wisdom relevance, invalidating cached matches
+	wae.invalidateMatchCache()
 }
 
 // GetWisdomMetrics returns wisdom application statistics
@@ -459,6 +652,45 @@ func (wae *WisdomApplicationEngine) GetWisdomMetrics() map[string]interface{} {
 	}
 }
 
+// TopWisdom returns the most proven wisdom entries, ordered by success
+// rate with application count breaking ties. A non-positive limit
+// returns every entry. Returned entries are detached copies.
+func (wae *WisdomApplicationEngine) TopWisdom(limit int) []*WisdomEntry {
+	wae.mu.RLock()
+	defer wae.mu.RUnlock()
+
+	entries := make([]*WisdomEntry, 0, len(wae.wisdomBase))
+	for _, wisdom := range wae.wisdomBase {
+		clone := *wisdom
+		clone.Applicability = append([]string(nil), wisdom.Applicability...)
+		clone.Tags = append([]string(nil), wisdom.Tags...)
+		clone.RelatedWisdom = append([]string(nil), wisdom.RelatedWisdom...)
+		if wisdom.Metadata != nil {
+			clone.Metadata = make(map[string]interface{}, len(wisdom.Metadata))
+			for key, value := range wisdom.Metadata {
+				clone.Metadata[key] = value
+			}
+		}
+		entries = append(entries, &clone)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].SuccessRate != entries[j].SuccessRate {
+			return entries[i].SuccessRate > entries[j].SuccessRate
+		}
+		if entries[i].TimesApplied != entries[j].TimesApplied {
+			return entries[i].TimesApplied > entries[j].TimesApplied
+		}
+		return entries[i].ID < entries[j].ID
+	})
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries
+}
+
 // Helper functions
 
 func (wae *WisdomApplicationEngine) calculateRelevance(context string, wisdom *WisdomEntry) *RelevanceScore {
@@ -468,8 +700,12 @@ func (wae *WisdomApplicationEngine) calculateRelevance(context string, wisdom *W
 		ComputedAt: time.Now(),
 	}
 	
-	// Calculate applicability
-	score.Applicability = calculateApplicability(context, wisdom)
+	// Calculate applicability, semantically when an embedder is wired
+	if similarity, ok := wae.semanticSimilarity(context, wisdom); ok {
+		score.Applicability = similarity
+	} else {
+		score.Applicability = calculateApplicability(context, wisdom)
+	}
 	
 	// Calculate timeliness
 	timeSinceApplied := time.Since(wisdom.LastApplied)
@@ -587,7 +823,8 @@ func newContextMatcher() *ContextMatcher {
 		exactMatchWeight:    0.5,
 		semanticMatchWeight: 0.3,
 		analogyMatchWeight:  0.2,
-		matchCache:          make(map[string][]*WisdomMatch),
+		matchCache:          make(map[string]*cachedMatch),
+		cacheTTL:            defaultMatchCacheTTL,
 	}
 }
 
@@ -604,12 +841,67 @@ func calculateApplicability(context string, wisdom *WisdomEntry) float64 {
 }
 
 func contextContains(context, term string) bool {
-	// Simplified - in practice would use NLP or strings.Contains
 	if len(context) == 0 || len(term) == 0 {
 		return false
 	}
-	// Basic heuristic: check if context is long enough to potentially contain term
-	return len(context) >= len(term)
+
+	context = strings.ToLower(context)
+	term = strings.ToLower(term)
+
+	// Direct phrase hit on the lowercased input
+	if strings.Contains(context, term) {
+		return true
+	}
+
+	contextTokens := tokenizeContext(context)
+	termTokens := tokenizeContext(term)
+
+	// Single-word terms must match a whole token, with enough stem
+	// tolerance that "learn" still hits "learning"
+	if len(termTokens) == 1 {
+		for _, token := range contextTokens {
+			if tokensShareStem(token, termTokens[0]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Multi-word terms match fuzzily: a majority of their words
+	// appearing in the context counts as a hit
+	hits := 0
+	for _, termToken := range termTokens {
+		for _, token := range contextTokens {
+			if tokensShareStem(token, termToken) {
+				hits++
+				break
+			}
+		}
+	}
+	return hits*2 >= len(termTokens)
+}
+
+// tokenizeContext splits text into lowercase word tokens
+func tokenizeContext(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// tokensShareStem reports whether two words agree up to a short suffix,
+// so inflections like "decision"/"decisions" count as the same word
+func tokensShareStem(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if len(a) < 4 || len(b) < 4 {
+		return false
+	}
+	shorter, longer := a, b
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	return strings.HasPrefix(longer, shorter) && len(longer)-len(shorter) <= 3
 }
 
 func determineMatchType(context string, wisdom *WisdomEntry) MatchType {
@@ -631,15 +923,14 @@ func generateMatchExplanation(context string, wisdom *WisdomEntry, matchType Mat
 }
 
 func sortByRelevance(matches []*WisdomMatch) {
-	// Simple bubble sort by relevance score
-	n := len(matches)
-	for i := 0; i < n-1; i++ {
-		for j := 0; j < n-i-1; j++ {
-			if matches[j].RelevanceScore < matches[j+1].RelevanceScore {
-				matches[j], matches[j+1] = matches[j+1], matches[j]
-			}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].RelevanceScore != matches[j].RelevanceScore {
+			return matches[i].RelevanceScore > matches[j].RelevanceScore
 		}
-	}
+		// Tiebreak on confidence so equal-relevance wisdom orders
+		// deterministically
+		return matches[i].Confidence > matches[j].Confidence
+	})
 }
 
 // Wisdom synthesizer helpers
@@ -752,3 +1043,196 @@ func generateWisdomID() string {
 func generateSynthesisID() string {
 	return fmt.Sprintf("synthesis_%d", time.Now().UnixNano())
 }
+
+// DeleteWisdom removes an entry from the base, its category index, and
+// any RelatedWisdom references pointing at it
+func (wae *WisdomApplicationEngine) DeleteWisdom(id string) error {
+	wae.mu.Lock()
+	defer wae.mu.Unlock()
+	return wae.deleteWisdomLocked(id)
+}
+
+// deleteWisdomLocked removes a wisdom entry; caller must hold the lock
+func (wae *WisdomApplicationEngine) deleteWisdomLocked(id string) error {
+	entry, exists := wae.wisdomBase[id]
+	if !exists {
+		return fmt.Errorf("wisdom %s not found", id)
+	}
+
+	delete(wae.wisdomBase, id)
+
+	// Remove from the category index
+	entries := wae.wisdomCategories[entry.Category]
+	for i, e := range entries {
+		if e.ID == id {
+			wae.wisdomCategories[entry.Category] = append(entries[:i], entries[i+1:]...)
+			break
+		}
+	}
+
+	// Drop dangling RelatedWisdom references
+	for _, other := range wae.wisdomBase {
+		for i, related := range other.RelatedWisdom {
+			if related == id {
+				other.RelatedWisdom = append(other.RelatedWisdom[:i], other.RelatedWisdom[i+1:]...)
+				break
+			}
+		}
+	}
+
+	// Remove from the synthesized list
+	for i, e := range wae.synthesizedWisdom {
+		if e.ID == id {
+			wae.synthesizedWisdom = append(wae.synthesizedWisdom[:i], wae.synthesizedWisdom[i+1:]...)
+			break
+		}
+	}
+
+	wae.invalidateMatchCache()
+
+	return nil
+}
+
+// DecayWisdom lowers usefulness for long-idle entries and deletes
+// synthesized entries whose usefulness has fallen below the floor.
+// Foundational wisdom is never deleted. Returns the number removed.
+func (wae *WisdomApplicationEngine) DecayWisdom() int {
+	wae.mu.Lock()
+	defer wae.mu.Unlock()
+
+	removed := 0
+	for id, entry := range wae.wisdomBase {
+		idleFor := time.Since(entry.LastApplied)
+		if entry.TimesApplied == 0 {
+			idleFor = time.Since(entry.CreatedAt)
+		}
+		if idleFor > wisdomIdleThreshold {
+			entry.Usefulness *= wisdomUsefulnessDecay
+		}
+
+		if entry.Source == "wisdom_synthesis" && entry.Usefulness < wisdomUsefulnessFloor {
+			wae.deleteWisdomLocked(id)
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// SetPruneInterval overrides how often the decay loop runs
+func (wae *WisdomApplicationEngine) SetPruneInterval(interval time.Duration) {
+	wae.mu.Lock()
+	defer wae.mu.Unlock()
+	wae.pruneInterval = interval
+}
+
+// StartDecayLoop runs DecayWisdom on the prune interval until the
+// engine's context is cancelled
+func (wae *WisdomApplicationEngine) StartDecayLoop() {
+	wae.mu.RLock()
+	interval := wae.pruneInterval
+	wae.mu.RUnlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-wae.ctx.Done():
+				return
+			case <-ticker.C:
+				wae.DecayWisdom()
+			}
+		}
+	}()
+}
+
+// Wisdom base persistence
+
+// wisdomStateKey is the state key the wisdom base persists under
+const wisdomStateKey = "wisdom_application_state"
+
+// wisdomEngineState is the envelope saved through the store's
+// key-value state API
+type wisdomEngineState struct {
+	WisdomBase map[string]*WisdomEntry `json:"wisdom_base"`
+	Categories map[string][]string     `json:"categories"` // category -> wisdom IDs
+}
+
+// Save persists the wisdom base and category index so synthesized
+// wisdom and accumulated application stats survive restarts
+func (wae *WisdomApplicationEngine) Save(store persistence.Store) error {
+	wae.mu.RLock()
+
+	state := wisdomEngineState{
+		WisdomBase: make(map[string]*WisdomEntry, len(wae.wisdomBase)),
+		Categories: make(map[string][]string, len(wae.wisdomCategories)),
+	}
+	for id, entry := range wae.wisdomBase {
+		copied := *entry
+		state.WisdomBase[id] = &copied
+	}
+	for category, entries := range wae.wisdomCategories {
+		ids := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			ids = append(ids, entry.ID)
+		}
+		state.Categories[category] = ids
+	}
+
+	wae.mu.RUnlock()
+
+	if err := store.SaveState(wisdomStateKey, state); err != nil {
+		return fmt.Errorf("failed to save wisdom base: %w", err)
+	}
+
+	return nil
+}
+
+// Load restores a wisdom base saved by Save, merging the persisted
+// entries over the foundational seeds rather than clobbering them
+func (wae *WisdomApplicationEngine) Load(store persistence.Store) error {
+	var state wisdomEngineState
+	if err := store.GetState(wisdomStateKey, &state); err != nil {
+		return fmt.Errorf("failed to load wisdom base: %w", err)
+	}
+
+	wae.mu.Lock()
+	defer wae.mu.Unlock()
+
+	// Foundational seeds get fresh IDs each construction, so match
+	// persisted entries by content to avoid duplicating them
+	existingByContent := make(map[string]*WisdomEntry, len(wae.wisdomBase))
+	for _, entry := range wae.wisdomBase {
+		existingByContent[entry.Content] = entry
+	}
+
+	for id, entry := range state.WisdomBase {
+		if current, ok := existingByContent[entry.Content]; ok {
+			// Same wisdom reseeded under a new ID: keep the current
+			// entry but carry over its accumulated stats
+			current.TimesApplied = entry.TimesApplied
+			current.SuccessRate = entry.SuccessRate
+			current.Usefulness = entry.Usefulness
+			current.LastApplied = entry.LastApplied
+			continue
+		}
+		wae.wisdomBase[id] = entry
+	}
+
+	// Rebuild the category index and synthesis list over the merged base
+	wae.wisdomCategories = make(map[string][]*WisdomEntry)
+	wae.synthesizedWisdom = make([]*WisdomEntry, 0)
+	for _, entry := range wae.wisdomBase {
+		wae.wisdomCategories[entry.Category] = append(wae.wisdomCategories[entry.Category], entry)
+		if entry.Source == "wisdom_synthesis" {
+			wae.synthesizedWisdom = append(wae.synthesizedWisdom, entry)
+		}
+	}
+
+	// The merged base invalidates any cached matches
+	wae.invalidateMatchCache()
+
+	return nil
+}
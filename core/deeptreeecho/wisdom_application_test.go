@@ -0,0 +1,539 @@
+package deeptreeecho
+
+import (
+	"math/rand"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EchoCog/echollama/core/persistence"
+)
+
+func TestRecommendWisdomForGoals(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+
+	goal := &OrchGoal{
+		ID:          "goal-wisdom-1",
+		Description: "Cultivate wisdom through reflective learning and continuous growth",
+		Type:        GoalTypeWisdomCultivation,
+		Priority:    0.9,
+		CreatedAt:   time.Now(),
+	}
+
+	recommendations := wae.RecommendWisdomForGoals([]*OrchGoal{goal, nil}, 3)
+
+	matches, ok := recommendations[goal.ID]
+	if !ok {
+		t.Fatal("expected recommendations for the wisdom-cultivation goal")
+	}
+	if len(matches) == 0 {
+		t.Fatal("expected at least one wisdom match for a wisdom-cultivation goal")
+	}
+	if len(matches) > 3 {
+		t.Errorf("expected at most 3 matches, got %d", len(matches))
+	}
+
+	foundFoundational := false
+	for _, match := range matches {
+		if match.Wisdom == nil {
+			t.Fatal("match missing wisdom entry")
+		}
+		if match.Wisdom.Source == "foundational" {
+			foundFoundational = true
+		}
+	}
+	if !foundFoundational {
+		t.Error("expected foundational wisdom to surface for a wisdom-cultivation goal")
+	}
+
+	// Matches come back sorted by relevance
+	for i := 1; i < len(matches); i++ {
+		if matches[i].RelevanceScore > matches[i-1].RelevanceScore {
+			t.Error("matches should be sorted by descending relevance")
+		}
+	}
+}
+
+func TestFeedbackInvalidatesMatchCache(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+
+	context := "learning from experience and growth"
+	first := wae.FindRelevantWisdom(context, 3)
+	if len(first) == 0 {
+		t.Fatal("expected matches for a learning context")
+	}
+
+	// A repeated query is served from cache: same backing array
+	cached := wae.FindRelevantWisdom(context, 3)
+	if &cached[0] != &first[0] {
+		t.Fatal("repeated query should be served from cache")
+	}
+
+	wae.ProvideFeedback("some-application", 0.9, "worked well")
+
+	// Feedback mutates relevance, so the next query must recompute
+	recomputed := wae.FindRelevantWisdom(context, 3)
+	if len(recomputed) > 0 && &recomputed[0] == &first[0] {
+		t.Error("query after feedback should recompute, not return stale cache")
+	}
+}
+
+func TestMatchCacheEntriesExpire(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+	wae.SetMatchCacheTTL(10 * time.Millisecond)
+
+	context := "learning from experience and growth"
+	first := wae.FindRelevantWisdom(context, 3)
+	if len(first) == 0 {
+		t.Fatal("expected matches for a learning context")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	expired := wae.FindRelevantWisdom(context, 3)
+	if len(expired) > 0 && &expired[0] == &first[0] {
+		t.Error("expired cache entry should not be returned")
+	}
+}
+
+func TestFindRelevantWisdomConcurrentQueriesComputeOnce(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+
+	context := "learning from experience and growth"
+	const goroutines = 32
+	const iterations = 25
+
+	results := make([][]*WisdomMatch, goroutines)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(slot int) {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				results[slot] = wae.FindRelevantWisdom(context, 3)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	first := results[0]
+	if len(first) == 0 {
+		t.Fatal("expected matches for a learning context")
+	}
+
+	// Identical queries share one cached computation: every goroutine
+	// must see the same backing array
+	for i := 1; i < goroutines; i++ {
+		if len(results[i]) != len(first) || &results[i][0] != &first[0] {
+			t.Fatalf("goroutine %d got a separately computed result", i)
+		}
+	}
+}
+
+func TestDeleteWisdomRemovesAllReferences(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+
+	wae.mu.RLock()
+	sourceIDs := make([]string, 0, 2)
+	for id := range wae.wisdomBase {
+		sourceIDs = append(sourceIDs, id)
+		if len(sourceIDs) == 2 {
+			break
+		}
+	}
+	wae.mu.RUnlock()
+
+	synthesized := wae.SynthesizeWisdom(sourceIDs)
+	if synthesized == nil {
+		t.Fatal("synthesis failed")
+	}
+
+	// RelatedWisdom aliases the slice passed to SynthesizeWisdom, so
+	// snapshot the ID before deletion mutates it in place
+	deletedID := sourceIDs[0]
+
+	if err := wae.DeleteWisdom(deletedID); err != nil {
+		t.Fatalf("DeleteWisdom failed: %v", err)
+	}
+
+	wae.mu.RLock()
+	defer wae.mu.RUnlock()
+
+	if _, exists := wae.wisdomBase[deletedID]; exists {
+		t.Error("deleted wisdom should leave the base")
+	}
+	for _, entries := range wae.wisdomCategories {
+		for _, entry := range entries {
+			if entry.ID == deletedID {
+				t.Error("deleted wisdom should leave the category index")
+			}
+		}
+	}
+	for _, related := range synthesized.RelatedWisdom {
+		if related == deletedID {
+			t.Error("RelatedWisdom references to the deleted entry should be dropped")
+		}
+	}
+}
+
+func TestDeleteWisdomUnknownID(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+	if err := wae.DeleteWisdom("missing"); err == nil {
+		t.Error("expected error for unknown wisdom ID")
+	}
+}
+
+func TestDecayWisdomPrunesStaleSynthesizedOnly(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+
+	wae.mu.RLock()
+	sourceIDs := make([]string, 0, 2)
+	for id := range wae.wisdomBase {
+		sourceIDs = append(sourceIDs, id)
+		if len(sourceIDs) == 2 {
+			break
+		}
+	}
+	foundationalCount := len(wae.wisdomBase)
+	wae.mu.RUnlock()
+
+	synthesized := wae.SynthesizeWisdom(sourceIDs)
+	if synthesized == nil {
+		t.Fatal("synthesis failed")
+	}
+
+	// Make everything look long idle and barely useful
+	wae.mu.Lock()
+	for _, entry := range wae.wisdomBase {
+		entry.CreatedAt = time.Now().Add(-30 * 24 * time.Hour)
+		entry.Usefulness = 0.1
+	}
+	wae.mu.Unlock()
+
+	removed := wae.DecayWisdom()
+	if removed != 1 {
+		t.Errorf("expected only the synthesized entry to be removed, got %d", removed)
+	}
+
+	wae.mu.RLock()
+	defer wae.mu.RUnlock()
+
+	if _, exists := wae.wisdomBase[synthesized.ID]; exists {
+		t.Error("stale synthesized wisdom should be deleted")
+	}
+	if len(wae.wisdomBase) != foundationalCount {
+		t.Errorf("foundational wisdom must never be deleted, %d entries left", len(wae.wisdomBase))
+	}
+	for _, entry := range wae.wisdomBase {
+		if entry.Usefulness >= 0.1 {
+			t.Errorf("idle wisdom should lose usefulness, got %f", entry.Usefulness)
+		}
+	}
+}
+
+func TestSortByRelevanceOrdersAndTiebreaks(t *testing.T) {
+	matches := []*WisdomMatch{
+		{WisdomID: "low", RelevanceScore: 0.2, Confidence: 0.9},
+		{WisdomID: "high-confident", RelevanceScore: 0.8, Confidence: 0.9},
+		{WisdomID: "high-unsure", RelevanceScore: 0.8, Confidence: 0.4},
+		{WisdomID: "mid", RelevanceScore: 0.5, Confidence: 0.1},
+	}
+
+	sortByRelevance(matches)
+
+	want := []string{"high-confident", "high-unsure", "mid", "low"}
+	for i, id := range want {
+		if matches[i].WisdomID != id {
+			t.Fatalf("position %d: want %s, got %s", i, id, matches[i].WisdomID)
+		}
+	}
+}
+
+func BenchmarkSortByRelevance5000(b *testing.B) {
+	source := make([]*WisdomMatch, 5000)
+	rng := rand.New(rand.NewSource(1))
+	for i := range source {
+		source[i] = &WisdomMatch{
+			RelevanceScore: rng.Float64(),
+			Confidence:     rng.Float64(),
+		}
+	}
+
+	matches := make([]*WisdomMatch, len(source))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(matches, source)
+		sortByRelevance(matches)
+	}
+}
+
+func TestWisdomBaseSurvivesRestart(t *testing.T) {
+	store, err := persistence.NewSQLiteStore(filepath.Join(t.TempDir(), "wisdom.db"))
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	first := NewWisdomApplicationEngine()
+
+	// Pick two foundational entries to synthesize from
+	first.mu.RLock()
+	sourceIDs := make([]string, 0, 2)
+	for id := range first.wisdomBase {
+		sourceIDs = append(sourceIDs, id)
+		if len(sourceIDs) == 2 {
+			break
+		}
+	}
+	foundationalCount := len(first.wisdomBase)
+	first.mu.RUnlock()
+
+	synthesized := first.SynthesizeWisdom(sourceIDs)
+	if synthesized == nil {
+		t.Fatal("synthesis failed")
+	}
+
+	// Accumulate application stats on the synthesized entry and a
+	// foundational one
+	first.ApplyWisdom(synthesized.ID, "a learning context")
+	first.ApplyWisdom(synthesized.ID, "another learning context")
+	first.ApplyWisdom(sourceIDs[0], "a learning context")
+
+	if err := first.Save(store); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	second := NewWisdomApplicationEngine()
+	if err := second.Load(store); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+
+	restored, ok := second.wisdomBase[synthesized.ID]
+	if !ok {
+		t.Fatal("synthesized wisdom should survive the restart")
+	}
+	if restored.TimesApplied != 2 {
+		t.Errorf("TimesApplied not restored, got %d", restored.TimesApplied)
+	}
+
+	// Foundational stats carry over onto the reseeded entry
+	firstFoundational := first.wisdomBase[sourceIDs[0]]
+	for _, entry := range second.wisdomBase {
+		if entry.Content == firstFoundational.Content {
+			if entry.TimesApplied != 1 || entry.SuccessRate != firstFoundational.SuccessRate {
+				t.Errorf("foundational stats not carried over: applied=%d rate=%f",
+					entry.TimesApplied, entry.SuccessRate)
+			}
+		}
+	}
+
+	// Foundational wisdom is merged, not clobbered
+	if len(second.wisdomBase) != foundationalCount+1 {
+		t.Errorf("expected %d entries after merge, got %d", foundationalCount+1, len(second.wisdomBase))
+	}
+
+	// Category index covers the synthesized entry
+	found := false
+	for _, entry := range second.wisdomCategories["synthesized"] {
+		if entry.ID == synthesized.ID {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("category index should include the synthesized entry")
+	}
+	if len(second.synthesizedWisdom) != 1 {
+		t.Errorf("synthesized list not rebuilt, got %d entries", len(second.synthesizedWisdom))
+	}
+}
+
+// stubEmbedder maps text onto a crude bag-of-words vector over a fixed
+// vocabulary, enough to exercise cosine similarity deterministically
+type stubEmbedder struct {
+	calls int
+}
+
+func (s *stubEmbedder) Embed(text string) ([]float64, error) {
+	s.calls++
+	vocabulary := []string{"learning", "growth", "patience", "risk"}
+	vector := make([]float64, len(vocabulary))
+	lowered := strings.ToLower(text)
+	for i, word := range vocabulary {
+		if strings.Contains(lowered, word) {
+			vector[i] = 1.0
+		}
+	}
+	return vector, nil
+}
+
+func TestEmbeddingProviderDrivesSemanticMatching(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+
+	aligned := &WisdomEntry{
+		ID:            "wisdom-aligned",
+		Content:       "learning and growth compound over time",
+		Applicability: []string{"learning", "growth"},
+		Usefulness:    0.5,
+		Specificity:   0.5,
+	}
+	unrelated := &WisdomEntry{
+		ID:            "wisdom-unrelated",
+		Content:       "weigh risk with patience",
+		Applicability: []string{"risk"},
+		Usefulness:    0.5,
+		Specificity:   0.5,
+	}
+
+	wae.mu.Lock()
+	wae.wisdomBase = map[string]*WisdomEntry{
+		aligned.ID:   aligned,
+		unrelated.ID: unrelated,
+	}
+	wae.mu.Unlock()
+
+	embedder := &stubEmbedder{}
+	wae.SetEmbeddingProvider(embedder)
+
+	matches := wae.FindRelevantWisdom("continuous learning fuels growth", 2)
+	if len(matches) == 0 {
+		t.Fatal("expected semantic matches")
+	}
+	if matches[0].WisdomID != aligned.ID {
+		t.Errorf("semantically aligned wisdom should rank first, got %s", matches[0].WisdomID)
+	}
+	if matches[0].MatchType != MatchExact {
+		t.Errorf("identical embedding should classify as exact, got %s", matches[0].MatchType.String())
+	}
+	if embedder.calls == 0 {
+		t.Error("embedder should have been consulted")
+	}
+
+	// Embeddings are cached per text, so a repeated query after cache
+	// invalidation must not re-embed the same strings
+	callsAfterFirst := embedder.calls
+	wae.mu.Lock()
+	wae.invalidateMatchCache()
+	wae.mu.Unlock()
+	wae.FindRelevantWisdom("continuous learning fuels growth", 2)
+	if embedder.calls != callsAfterFirst {
+		t.Errorf("embeddings should be cached, got %d extra calls", embedder.calls-callsAfterFirst)
+	}
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	if sim := cosineSimilarity([]float64{1, 0}, []float64{1, 0}); sim != 1.0 {
+		t.Errorf("identical vectors should score 1.0, got %f", sim)
+	}
+	if sim := cosineSimilarity([]float64{1, 0}, []float64{0, 1}); sim != 0.0 {
+		t.Errorf("orthogonal vectors should score 0.0, got %f", sim)
+	}
+	if sim := cosineSimilarity([]float64{1, 0}, []float64{1, 0, 0}); sim != 0.0 {
+		t.Errorf("mismatched lengths should score 0.0, got %f", sim)
+	}
+	if sim := cosineSimilarity([]float64{0, 0}, []float64{1, 0}); sim != 0.0 {
+		t.Errorf("zero vector should score 0.0, got %f", sim)
+	}
+}
+
+func TestContextContainsMatchesTokens(t *testing.T) {
+	cases := []struct {
+		context string
+		term    string
+		want    bool
+	}{
+		{"learning from experience", "learning", true},
+		{"Learning From Experience", "learning", true},   // case-insensitive
+		{"I learn best by doing", "learning", true},      // shared stem
+		{"weighing difficult decisions", "decision", true},
+		{"planning the next sprint", "learning", false},  // no shared stem
+		{"a long context about nothing relevant at all", "learning", false},
+		{"", "learning", false},
+		{"learning from experience", "", false},
+		// Multi-word terms match on a majority of their words
+		{"we are learning from failure here", "learning from experience", true},
+		{"completely unrelated text", "learning from experience", false},
+	}
+
+	for _, tc := range cases {
+		if got := contextContains(tc.context, tc.term); got != tc.want {
+			t.Errorf("contextContains(%q, %q) = %v, want %v", tc.context, tc.term, got, tc.want)
+		}
+	}
+}
+
+func TestCalculateApplicabilityScoresRealHits(t *testing.T) {
+	wisdom := &WisdomEntry{
+		Applicability: []string{"learning", "growth", "decision_making"},
+	}
+
+	if score := calculateApplicability("learning from experience drives growth", wisdom); score != 0.6 {
+		t.Errorf("expected two hits worth 0.6, got %f", score)
+	}
+	if score := calculateApplicability("totally unrelated topic", wisdom); score != 0.0 {
+		t.Errorf("unrelated context should score 0, got %f", score)
+	}
+
+	// Scores cap at 1.0 even with many applicable entries
+	broad := &WisdomEntry{
+		Applicability: []string{"learning", "learn", "experience", "growth"},
+	}
+	if score := calculateApplicability("learning from experience drives growth", broad); score != 1.0 {
+		t.Errorf("score should cap at 1.0, got %f", score)
+	}
+}
+
+func TestTopWisdomOrdersAndLimits(t *testing.T) {
+	wae := NewWisdomApplicationEngine()
+
+	// Mark one foundational entry as clearly proven
+	var provenID string
+	wae.mu.Lock()
+	for id, wisdom := range wae.wisdomBase {
+		wisdom.SuccessRate = 0.9
+		wisdom.TimesApplied = 12
+		provenID = id
+		break
+	}
+	wae.mu.Unlock()
+
+	top := wae.TopWisdom(3)
+	if len(top) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(top))
+	}
+	if top[0].ID != provenID {
+		t.Errorf("highest success rate should rank first, got %s", top[0].ID)
+	}
+	for i := 1; i < len(top); i++ {
+		if top[i].SuccessRate > top[i-1].SuccessRate {
+			t.Errorf("entries out of order at %d: %f > %f", i, top[i].SuccessRate, top[i-1].SuccessRate)
+		}
+	}
+
+	wae.mu.RLock()
+	total := len(wae.wisdomBase)
+	wae.mu.RUnlock()
+	if all := wae.TopWisdom(0); len(all) != total {
+		t.Errorf("non-positive limit should return all %d entries, got %d", total, len(all))
+	}
+
+	// Returned copies are detached from engine state
+	top[0].Tags = append(top[0].Tags, "tampered")
+	top[0].Content = "tampered"
+	wae.mu.RLock()
+	original := wae.wisdomBase[provenID]
+	tampered := original.Content == "tampered"
+	for _, tag := range original.Tags {
+		if tag == "tampered" {
+			tampered = true
+		}
+	}
+	wae.mu.RUnlock()
+	if tampered {
+		t.Error("TopWisdom must return defensive copies")
+	}
+}
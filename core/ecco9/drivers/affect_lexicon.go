@@ -0,0 +1,113 @@
+package drivers
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// affectLexicon maps words to the Izard emotion channel they evoke.
+// Deliberately dependency-light: a small embedded lexicon rather than an
+// external NLP model.
+var affectLexicon = map[string]string{
+	// interest
+	"curious": "interest", "interesting": "interest", "intriguing": "interest",
+	"fascinating": "interest", "wonder": "interest", "explore": "interest",
+
+	// joy
+	"happy": "joy", "joy": "joy", "joyful": "joy", "delighted": "joy",
+	"wonderful": "joy", "great": "joy", "love": "joy", "celebrate": "joy",
+	"excited": "joy", "glad": "joy", "thrilled": "joy", "cheerful": "joy",
+
+	// surprise
+	"surprised": "surprise", "surprising": "surprise", "astonished": "surprise",
+	"amazed": "surprise", "unexpected": "surprise", "shocking": "surprise",
+	"sudden": "surprise",
+
+	// sadness
+	"sad": "sadness", "grief": "sadness", "mourning": "sadness",
+	"miserable": "sadness", "depressed": "sadness", "sorrow": "sadness",
+	"lonely": "sadness", "crying": "sadness", "heartbroken": "sadness",
+
+	// anger
+	"angry": "anger", "anger": "anger", "furious": "anger", "rage": "anger",
+	"hate": "anger", "outraged": "anger", "annoyed": "anger", "mad": "anger",
+	"irritated": "anger", "infuriating": "anger",
+
+	// disgust
+	"disgusting": "disgust", "disgusted": "disgust", "gross": "disgust",
+	"revolting": "disgust", "nauseating": "disgust", "vile": "disgust",
+
+	// contempt
+	"contempt": "contempt", "scorn": "contempt", "disdain": "contempt",
+	"pathetic": "contempt", "worthless": "contempt",
+
+	// fear
+	"afraid": "fear", "scared": "fear", "terrified": "fear", "fear": "fear",
+	"panic": "fear", "dread": "fear", "anxious": "fear", "worried": "fear",
+	"frightening": "fear",
+
+	// shame
+	"ashamed": "shame", "embarrassed": "shame", "humiliated": "shame",
+	"shame": "shame",
+
+	// guilt
+	"guilty": "guilt", "guilt": "guilt", "regret": "guilt",
+	"remorse": "guilt", "sorry": "guilt",
+}
+
+// emotionAffect gives each channel's position in arousal/valence space,
+// used to derive dimensional affect from the detected emotions
+var emotionAffect = map[string]struct{ valence, arousal float64 }{
+	"interest": {0.70, 0.60},
+	"joy":      {0.90, 0.70},
+	"surprise": {0.60, 0.90},
+	"sadness":  {0.20, 0.30},
+	"anger":    {0.10, 0.90},
+	"disgust":  {0.15, 0.60},
+	"contempt": {0.20, 0.50},
+	"fear":     {0.10, 0.85},
+	"shame":    {0.20, 0.40},
+	"guilt":    {0.20, 0.45},
+}
+
+// analyzeAffect runs the lexicon over the input and returns the evoked
+// channel intensities plus derived arousal and valence. The bool is
+// false when no affect-bearing words were found.
+func analyzeAffect(input string) (map[string]float64, float64, float64, bool) {
+	words := strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	hits := make(map[string]int)
+	for _, word := range words {
+		if emotion, ok := affectLexicon[word]; ok {
+			hits[emotion]++
+		}
+	}
+	if len(hits) == 0 {
+		return nil, 0, 0, false
+	}
+
+	// Repeated affect words intensify the channel with diminishing returns
+	intensities := make(map[string]float64, len(hits))
+	for emotion, count := range hits {
+		intensities[emotion] = 1.0 - math.Pow(0.5, float64(count))
+	}
+
+	// Arousal and valence are the intensity-weighted blend of each
+	// detected emotion's position in affect space
+	totalWeight := 0.0
+	valence := 0.0
+	arousal := 0.0
+	for emotion, intensity := range intensities {
+		affect := emotionAffect[emotion]
+		valence += affect.valence * intensity
+		arousal += affect.arousal * intensity
+		totalWeight += intensity
+	}
+	valence /= totalWeight
+	arousal /= totalWeight
+
+	return intensities, arousal, valence, true
+}
@@ -121,6 +121,13 @@ func (cd *ConsciousnessDriver) GetCapabilities() []string {
 	}
 }
 
+// GetConfig exposes the loaded config for platform snapshots
+func (cd *ConsciousnessDriver) GetConfig() interface{} {
+	cd.mu.RLock()
+	defer cd.mu.RUnlock()
+	return cd.config
+}
+
 // ConsciousnessDevice represents a Consciousness Layer Processor
 type ConsciousnessDevice struct {
 	mu          sync.RWMutex
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"time"
 
@@ -24,8 +25,13 @@ type EmotionConfig struct {
 	NumChannels   int
 	DecayRate     float64
 	BlendingAlpha float64
+	DecayInterval time.Duration
 }
 
+// decayReferenceInterval is the tick rate DecayRate is defined against;
+// slower loop intervals scale the decay so behavior is unchanged
+const decayReferenceInterval = 100 * time.Millisecond
+
 // NewEmotionDriver creates a new emotion driver
 func NewEmotionDriver() *EmotionDriver {
 	return &EmotionDriver{
@@ -42,6 +48,7 @@ func DefaultEmotionConfig() *EmotionConfig {
 		NumChannels:   10, // Izard's Differential Emotion Theory
 		DecayRate:     0.05,
 		BlendingAlpha: 0.3,
+		DecayInterval: decayReferenceInterval,
 	}
 }
 
@@ -122,18 +129,51 @@ func (ed *EmotionDriver) GetCapabilities() []string {
 	}
 }
 
+// GetConfig exposes the loaded config for platform snapshots
+func (ed *EmotionDriver) GetConfig() interface{} {
+	ed.mu.RLock()
+	defer ed.mu.RUnlock()
+	return ed.config
+}
+
+// Emotion device IoCtl commands
+const (
+	// EmotionCmdSetDecayRate updates the decay rate at runtime;
+	// arg is a float64 in [0, 1)
+	EmotionCmdSetDecayRate uint32 = iota + 1
+	// EmotionCmdResetChannel zeroes a single emotion channel;
+	// arg is the channel name
+	EmotionCmdResetChannel
+	// EmotionCmdSetBaseline moves the neutral point decay settles
+	// toward; arg is an EmotionBaseline
+	EmotionCmdSetBaseline
+	// EmotionCmdFreeze pauses decay and input-driven updates;
+	// arg is a bool (true freezes, false resumes)
+	EmotionCmdFreeze
+)
+
+// EmotionBaseline is the IoCtl argument for EmotionCmdSetBaseline
+type EmotionBaseline struct {
+	Arousal float64
+	Valence float64
+}
+
 // EmotionDevice represents an Emotion Processing Unit
 type EmotionDevice struct {
-	mu        sync.RWMutex
-	id        string
-	name      string
-	state     ecco9.DeviceState
-	config    *EmotionConfig
-	emotions  map[string]*EmotionChannel
-	arousal   float64 // Dimensional affect: arousal
-	valence   float64 // Dimensional affect: valence
-	metrics   ecco9.DeviceMetrics
-	startTime time.Time
+	mu              sync.RWMutex
+	id              string
+	name            string
+	state           ecco9.DeviceState
+	config          *EmotionConfig
+	emotions        map[string]*EmotionChannel
+	arousal         float64 // Dimensional affect: arousal
+	valence         float64 // Dimensional affect: valence
+	baselineArousal float64 // Neutral point decay settles toward
+	baselineValence float64
+	frozen          bool // Freezes emotional state when set
+	metrics         ecco9.DeviceMetrics
+	startTime       time.Time
+	lastDecay       time.Time
 }
 
 // EmotionChannel represents a discrete emotion channel
@@ -146,12 +186,14 @@ type EmotionChannel struct {
 // NewEmotionDevice creates a new emotion device
 func NewEmotionDevice(id string, config *EmotionConfig) *EmotionDevice {
 	return &EmotionDevice{
-		id:       id,
-		name:     fmt.Sprintf("Emotion Processing Unit %s", id),
-		config:   config,
-		emotions: make(map[string]*EmotionChannel),
-		arousal:  0.5,
-		valence:  0.5,
+		id:              id,
+		name:            fmt.Sprintf("Emotion Processing Unit %s", id),
+		config:          config,
+		emotions:        make(map[string]*EmotionChannel),
+		arousal:         0.5,
+		valence:         0.5,
+		baselineArousal: 0.5,
+		baselineValence: 0.5,
 		state: ecco9.DeviceState{
 			ID:     id,
 			Name:   fmt.Sprintf("Emotion %s", id),
@@ -194,20 +236,26 @@ func (ed *EmotionDevice) Initialize(ctx context.Context) error {
 	}
 	
 	ed.startTime = time.Now()
+	ed.lastDecay = time.Now()
 	ed.state.Status = ecco9.DeviceStatusReady
 	ed.state.LastUpdate = time.Now()
-	
+
 	// Start decay ticker
 	go ed.runDecayLoop(ctx)
-	
+
 	return nil
 }
 
 // runDecayLoop applies automatic emotion decay
 func (ed *EmotionDevice) runDecayLoop(ctx context.Context) {
-	ticker := time.NewTicker(100 * time.Millisecond)
+	interval := ed.config.DecayInterval
+	if interval <= 0 {
+		interval = decayReferenceInterval
+	}
+
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
-	
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -222,17 +270,30 @@ func (ed *EmotionDevice) runDecayLoop(ctx context.Context) {
 func (ed *EmotionDevice) applyDecay() {
 	ed.mu.Lock()
 	defer ed.mu.Unlock()
-	
+
+	now := time.Now()
+	elapsed := now.Sub(ed.lastDecay)
+	ed.lastDecay = now
+
+	// A powered-down or frozen device holds its emotional state
+	if ed.state.Power != ecco9.PowerStateActive || ed.frozen {
+		return
+	}
+
+	// Time-based decay: equivalent to applying DecayRate once per
+	// reference interval, so slower tick rates produce the same curve
+	factor := math.Pow(1.0-ed.config.DecayRate, elapsed.Seconds()/decayReferenceInterval.Seconds())
+
 	for _, emotion := range ed.emotions {
-		emotion.Intensity *= (1.0 - ed.config.DecayRate)
+		emotion.Intensity *= factor
 		if emotion.Intensity < 0.01 {
 			emotion.Intensity = 0.0
 		}
 	}
-	
-	// Decay arousal and valence toward neutral
-	ed.arousal += (0.5 - ed.arousal) * ed.config.DecayRate
-	ed.valence += (0.5 - ed.valence) * ed.config.DecayRate
+
+	// Decay arousal and valence toward the baseline
+	ed.arousal = ed.baselineArousal + (ed.arousal-ed.baselineArousal)*factor
+	ed.valence = ed.baselineValence + (ed.valence-ed.baselineValence)*factor
 }
 
 // Shutdown implements CognitiveDevice.Shutdown
@@ -259,11 +320,20 @@ func (ed *EmotionDevice) Reset(ctx context.Context) error {
 func (ed *EmotionDevice) GetState() (ecco9.DeviceState, error) {
 	ed.mu.RLock()
 	defer ed.mu.RUnlock()
-	
+
 	state := ed.state
 	state.Uptime = time.Since(ed.startTime)
 	state.Metrics = ed.metrics
-	
+
+	// Expose the emotional state for snapshot/restore
+	state.Attributes = map[string]interface{}{
+		"arousal": ed.arousal,
+		"valence": ed.valence,
+	}
+	for name, channel := range ed.emotions {
+		state.Attributes["emotion_"+name] = channel.Intensity
+	}
+
 	return state, nil
 }
 
@@ -271,8 +341,22 @@ func (ed *EmotionDevice) GetState() (ecco9.DeviceState, error) {
 func (ed *EmotionDevice) SetState(state ecco9.DeviceState) error {
 	ed.mu.Lock()
 	defer ed.mu.Unlock()
-	
+
 	ed.state = state
+
+	// Apply snapshotted emotional state when present
+	if value, ok := state.Attributes["arousal"].(float64); ok {
+		ed.arousal = value
+	}
+	if value, ok := state.Attributes["valence"].(float64); ok {
+		ed.valence = value
+	}
+	for name, channel := range ed.emotions {
+		if value, ok := state.Attributes["emotion_"+name].(float64); ok {
+			channel.Intensity = value
+		}
+	}
+
 	return nil
 }
 
@@ -301,14 +385,10 @@ func (ed *EmotionDevice) Read(buffer []byte) (int, error) {
 		}
 	}
 	
-	// Sort by intensity (simple bubble sort for small arrays)
-	for i := 0; i < len(emotions); i++ {
-		for j := i + 1; j < len(emotions); j++ {
-			if emotions[j].intensity > emotions[i].intensity {
-				emotions[i], emotions[j] = emotions[j], emotions[i]
-			}
-		}
-	}
+	// Sort by descending intensity
+	sort.Slice(emotions, func(i, j int) bool {
+		return emotions[i].intensity > emotions[j].intensity
+	})
 	
 	// Add top emotions to status
 	for i := 0; i < len(emotions) && i < 3; i++ {
@@ -336,15 +416,24 @@ func (ed *EmotionDevice) Write(buffer []byte) (int, error) {
 	// Parse input to trigger emotions
 	input := string(buffer)
 	inputLen := float64(len(input))
-	
-	// Simple heuristic: trigger emotions based on input characteristics
-	if inputLen > 0 {
-		// Trigger interest based on input length
-		ed.setEmotion("interest", math.Min(inputLen/100.0, 1.0))
-		
-		// Update arousal and valence
-		ed.arousal = math.Min(0.5+inputLen/200.0, 1.0)
-		ed.valence = 0.5 + 0.2 // Slight positive bias
+
+	// A frozen device records the operation but holds its state
+	if !ed.frozen {
+		if intensities, arousal, valence, ok := analyzeAffect(input); ok {
+			// Lexicon hit: raise the evoked channels and move arousal
+			// and valence toward the detected affect
+			for emotion, intensity := range intensities {
+				ed.setEmotion(emotion, intensity)
+			}
+			ed.arousal = ed.arousal*(1.0-ed.config.BlendingAlpha) + arousal*ed.config.BlendingAlpha
+			ed.valence = ed.valence*(1.0-ed.config.BlendingAlpha) + valence*ed.config.BlendingAlpha
+		} else if inputLen > 0 {
+			// No affect-bearing words: fall back to length-driven interest
+			ed.setEmotion("interest", math.Min(inputLen/100.0, 1.0))
+
+			ed.arousal = math.Min(0.5+inputLen/200.0, 1.0)
+			ed.valence = 0.5 + 0.2 // Slight positive bias
+		}
 	}
 	
 	ed.metrics.OperationCount++
@@ -369,7 +458,57 @@ func (ed *EmotionDevice) setEmotion(name string, intensity float64) {
 
 // IoCtl implements CognitiveDevice.IoCtl
 func (ed *EmotionDevice) IoCtl(command uint32, arg interface{}) error {
-	// Implement device-specific control operations
+	ed.mu.Lock()
+	defer ed.mu.Unlock()
+
+	switch command {
+	case EmotionCmdSetDecayRate:
+		rate, ok := arg.(float64)
+		if !ok {
+			return fmt.Errorf("set decay rate expects float64 arg, got %T", arg)
+		}
+		if rate < 0 || rate >= 1 {
+			return fmt.Errorf("decay rate must be in [0, 1), got %f", rate)
+		}
+		ed.config.DecayRate = rate
+
+	case EmotionCmdResetChannel:
+		name, ok := arg.(string)
+		if !ok {
+			return fmt.Errorf("reset channel expects string arg, got %T", arg)
+		}
+		channel, exists := ed.emotions[name]
+		if !exists {
+			return fmt.Errorf("unknown emotion channel %s", name)
+		}
+		channel.Intensity = 0.0
+		channel.LastUpdate = time.Now()
+
+	case EmotionCmdSetBaseline:
+		baseline, ok := arg.(EmotionBaseline)
+		if !ok {
+			return fmt.Errorf("set baseline expects EmotionBaseline arg, got %T", arg)
+		}
+		if baseline.Arousal < 0 || baseline.Arousal > 1 || baseline.Valence < 0 || baseline.Valence > 1 {
+			return fmt.Errorf("baseline values must be in [0, 1], got arousal=%f valence=%f",
+				baseline.Arousal, baseline.Valence)
+		}
+		ed.baselineArousal = baseline.Arousal
+		ed.baselineValence = baseline.Valence
+
+	case EmotionCmdFreeze:
+		frozen, ok := arg.(bool)
+		if !ok {
+			return fmt.Errorf("freeze expects bool arg, got %T", arg)
+		}
+		ed.frozen = frozen
+
+	default:
+		return fmt.Errorf("unknown ioctl command %d", command)
+	}
+
+	ed.metrics.OperationCount++
+	ed.metrics.LastOperation = time.Now()
 	return nil
 }
 
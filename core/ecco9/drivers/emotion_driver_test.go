@@ -0,0 +1,300 @@
+package drivers
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/EchoCog/echollama/core/ecco9"
+)
+
+// newDecayTestDevice initializes an emotion device with the given decay
+// interval and a seeded interest emotion
+func newDecayTestDevice(t *testing.T, interval time.Duration) *EmotionDevice {
+	t.Helper()
+
+	config := DefaultEmotionConfig()
+	config.DecayInterval = interval
+
+	device := NewEmotionDevice("emotion-test", config)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	if err := device.Initialize(ctx); err != nil {
+		t.Fatalf("failed to initialize device: %v", err)
+	}
+
+	device.mu.Lock()
+	device.emotions["interest"].Intensity = 1.0
+	device.arousal = 1.0
+	device.mu.Unlock()
+
+	return device
+}
+
+func (ed *EmotionDevice) interestIntensity() float64 {
+	ed.mu.RLock()
+	defer ed.mu.RUnlock()
+	return ed.emotions["interest"].Intensity
+}
+
+func TestDecayIntervalDoesNotChangeCurve(t *testing.T) {
+	fast := newDecayTestDevice(t, 10*time.Millisecond)
+	slow := newDecayTestDevice(t, 60*time.Millisecond)
+
+	time.Sleep(400 * time.Millisecond)
+
+	fastIntensity := fast.interestIntensity()
+	slowIntensity := slow.interestIntensity()
+
+	if fastIntensity >= 1.0 || slowIntensity >= 1.0 {
+		t.Fatalf("decay did not run: fast=%f slow=%f", fastIntensity, slowIntensity)
+	}
+
+	// Time-based decay should reach roughly the same state over equal
+	// wall-clock time regardless of the tick interval
+	if math.Abs(fastIntensity-slowIntensity) > 0.15 {
+		t.Errorf("decay diverged across intervals: fast=%f slow=%f", fastIntensity, slowIntensity)
+	}
+}
+
+// newWriteTestDevice initializes an emotion device with decay effectively
+// disabled, so Write tests observe only the affect analysis
+func newWriteTestDevice(t *testing.T) *EmotionDevice {
+	t.Helper()
+
+	config := DefaultEmotionConfig()
+	config.DecayInterval = time.Hour
+
+	device := NewEmotionDevice("emotion-write-test", config)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	if err := device.Initialize(ctx); err != nil {
+		t.Fatalf("failed to initialize device: %v", err)
+	}
+
+	return device
+}
+
+func (ed *EmotionDevice) channelIntensity(name string) float64 {
+	ed.mu.RLock()
+	defer ed.mu.RUnlock()
+	return ed.emotions[name].Intensity
+}
+
+func (ed *EmotionDevice) affectState() (float64, float64) {
+	ed.mu.RLock()
+	defer ed.mu.RUnlock()
+	return ed.arousal, ed.valence
+}
+
+func TestWriteAngryTextRaisesAnger(t *testing.T) {
+	device := newWriteTestDevice(t)
+
+	input := "I am furious and angry at this, I hate it"
+	if _, err := device.Write([]byte(input)); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	anger := device.channelIntensity("anger")
+	if anger <= 0 {
+		t.Fatal("angry text should raise the anger channel")
+	}
+	for _, other := range []string{"joy", "interest", "fear", "sadness", "surprise"} {
+		if intensity := device.channelIntensity(other); intensity >= anger {
+			t.Errorf("%s (%f) should stay below anger (%f)", other, intensity, anger)
+		}
+	}
+
+	arousal, valence := device.affectState()
+	if valence >= 0.5 {
+		t.Errorf("angry text should push valence negative, got %f", valence)
+	}
+	if arousal <= 0.5 {
+		t.Errorf("angry text should push arousal up, got %f", arousal)
+	}
+}
+
+func TestWriteJoyfulTextRaisesJoy(t *testing.T) {
+	device := newWriteTestDevice(t)
+
+	input := "what a wonderful day, I am so happy and delighted"
+	if _, err := device.Write([]byte(input)); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	joy := device.channelIntensity("joy")
+	if joy <= 0 {
+		t.Fatal("joyful text should raise the joy channel")
+	}
+	for _, other := range []string{"anger", "fear", "sadness", "disgust", "contempt"} {
+		if intensity := device.channelIntensity(other); intensity >= joy {
+			t.Errorf("%s (%f) should stay below joy (%f)", other, intensity, joy)
+		}
+	}
+
+	if _, valence := device.affectState(); valence <= 0.5 {
+		t.Errorf("joyful text should push valence positive, got %f", valence)
+	}
+}
+
+func TestWriteNeutralTextFallsBackToInterest(t *testing.T) {
+	device := newWriteTestDevice(t)
+
+	input := "the report is on the table in the second drawer"
+	if _, err := device.Write([]byte(input)); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	if interest := device.channelIntensity("interest"); interest <= 0 {
+		t.Error("neutral text should still register length-driven interest")
+	}
+	for _, other := range []string{"anger", "joy", "fear", "sadness"} {
+		if intensity := device.channelIntensity(other); intensity != 0 {
+			t.Errorf("neutral text should not raise %s, got %f", other, intensity)
+		}
+	}
+}
+
+func TestIoCtlSetDecayRate(t *testing.T) {
+	device := newWriteTestDevice(t)
+
+	if err := device.IoCtl(EmotionCmdSetDecayRate, 0.25); err != nil {
+		t.Fatalf("IoCtl failed: %v", err)
+	}
+
+	device.mu.RLock()
+	rate := device.config.DecayRate
+	device.mu.RUnlock()
+	if rate != 0.25 {
+		t.Errorf("decay rate not updated: %f", rate)
+	}
+
+	if err := device.IoCtl(EmotionCmdSetDecayRate, "fast"); err == nil {
+		t.Error("non-float64 arg should fail")
+	}
+	if err := device.IoCtl(EmotionCmdSetDecayRate, 1.5); err == nil {
+		t.Error("out-of-range rate should fail")
+	}
+}
+
+func TestIoCtlResetChannel(t *testing.T) {
+	device := newWriteTestDevice(t)
+
+	if _, err := device.Write([]byte("I am so angry and furious")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if device.channelIntensity("anger") <= 0 {
+		t.Fatal("setup failed: anger channel not raised")
+	}
+
+	if err := device.IoCtl(EmotionCmdResetChannel, "anger"); err != nil {
+		t.Fatalf("IoCtl failed: %v", err)
+	}
+	if intensity := device.channelIntensity("anger"); intensity != 0 {
+		t.Errorf("reset channel should be zero, got %f", intensity)
+	}
+
+	if err := device.IoCtl(EmotionCmdResetChannel, "nostalgia"); err == nil {
+		t.Error("unknown channel should fail")
+	}
+	if err := device.IoCtl(EmotionCmdResetChannel, 42); err == nil {
+		t.Error("non-string arg should fail")
+	}
+}
+
+func TestIoCtlSetBaseline(t *testing.T) {
+	device := newWriteTestDevice(t)
+
+	if err := device.IoCtl(EmotionCmdSetBaseline, EmotionBaseline{Arousal: 0.2, Valence: 0.8}); err != nil {
+		t.Fatalf("IoCtl failed: %v", err)
+	}
+
+	// With a long elapsed interval decay settles onto the baseline
+	device.mu.Lock()
+	device.arousal = 1.0
+	device.valence = 0.1
+	device.lastDecay = time.Now().Add(-10 * time.Second)
+	device.mu.Unlock()
+	device.applyDecay()
+
+	arousal, valence := device.affectState()
+	if math.Abs(arousal-0.2) > 0.05 {
+		t.Errorf("arousal should settle toward 0.2, got %f", arousal)
+	}
+	if math.Abs(valence-0.8) > 0.05 {
+		t.Errorf("valence should settle toward 0.8, got %f", valence)
+	}
+
+	if err := device.IoCtl(EmotionCmdSetBaseline, EmotionBaseline{Arousal: 1.5, Valence: 0.5}); err == nil {
+		t.Error("out-of-range baseline should fail")
+	}
+	if err := device.IoCtl(EmotionCmdSetBaseline, "neutral"); err == nil {
+		t.Error("non-EmotionBaseline arg should fail")
+	}
+}
+
+func TestIoCtlFreezeHoldsState(t *testing.T) {
+	device := newWriteTestDevice(t)
+
+	if err := device.IoCtl(EmotionCmdFreeze, true); err != nil {
+		t.Fatalf("IoCtl failed: %v", err)
+	}
+
+	// Input no longer moves the emotional state
+	if _, err := device.Write([]byte("I am so angry and furious")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if intensity := device.channelIntensity("anger"); intensity != 0 {
+		t.Errorf("frozen device should ignore input affect, got %f", intensity)
+	}
+
+	// Decay is paused too
+	device.mu.Lock()
+	device.emotions["joy"].Intensity = 0.8
+	device.lastDecay = time.Now().Add(-10 * time.Second)
+	device.mu.Unlock()
+	device.applyDecay()
+	if intensity := device.channelIntensity("joy"); intensity != 0.8 {
+		t.Errorf("frozen device should not decay, got %f", intensity)
+	}
+
+	// Unfreezing restores normal behavior
+	if err := device.IoCtl(EmotionCmdFreeze, false); err != nil {
+		t.Fatalf("IoCtl failed: %v", err)
+	}
+	if _, err := device.Write([]byte("I am so angry and furious")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if device.channelIntensity("anger") <= 0 {
+		t.Error("unfrozen device should respond to input again")
+	}
+
+	if err := device.IoCtl(EmotionCmdFreeze, "yes"); err == nil {
+		t.Error("non-bool arg should fail")
+	}
+}
+
+func TestIoCtlUnknownCommand(t *testing.T) {
+	device := newWriteTestDevice(t)
+
+	if err := device.IoCtl(9999, nil); err == nil {
+		t.Error("unknown command should fail")
+	}
+}
+
+func TestDecayPausesWhenPoweredDown(t *testing.T) {
+	device := newDecayTestDevice(t, 10*time.Millisecond)
+
+	device.mu.Lock()
+	device.state.Power = ecco9.PowerStateOff
+	device.mu.Unlock()
+
+	time.Sleep(100 * time.Millisecond)
+
+	if intensity := device.interestIntensity(); intensity != 1.0 {
+		t.Errorf("powered-down device should hold emotional state, got %f", intensity)
+	}
+}
@@ -121,6 +121,13 @@ func (md *MemoryDriver) GetCapabilities() []string {
 	}
 }
 
+// GetConfig exposes the loaded config for platform snapshots
+func (md *MemoryDriver) GetConfig() interface{} {
+	md.mu.RLock()
+	defer md.mu.RUnlock()
+	return md.config
+}
+
 // MemoryDevice represents a Hypergraph Memory Array
 type MemoryDevice struct {
 	mu               sync.RWMutex
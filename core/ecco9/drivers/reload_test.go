@@ -0,0 +1,127 @@
+package drivers
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/EchoCog/echollama/core/ecco9"
+)
+
+func TestReloadEmotionDriverAppliesNewConfig(t *testing.T) {
+	platform := ecco9.NewPlatform(nil)
+	ctx := context.Background()
+
+	driver := NewEmotionDriver()
+	if err := driver.Load(DefaultEmotionConfig()); err != nil {
+		t.Fatalf("failed to load driver: %v", err)
+	}
+	if err := platform.RegisterDriver(driver); err != nil {
+		t.Fatalf("failed to register driver: %v", err)
+	}
+	for _, device := range driver.ListDevices() {
+		if err := device.Initialize(ctx); err != nil {
+			t.Fatalf("failed to initialize device: %v", err)
+		}
+		if err := platform.RegisterDevice(device); err != nil {
+			t.Fatalf("failed to register device: %v", err)
+		}
+	}
+
+	newConfig := DefaultEmotionConfig()
+	newConfig.DecayRate = 0.5
+	if err := platform.ReloadDriver("emotion", newConfig); err != nil {
+		t.Fatalf("ReloadDriver failed: %v", err)
+	}
+
+	// The device comes back under its old ID, online and with the new
+	// config applied
+	device, err := platform.GetDevice("emotion0")
+	if err != nil {
+		t.Fatalf("device ID not preserved across reload: %v", err)
+	}
+
+	state, err := device.GetState()
+	if err != nil {
+		t.Fatalf("GetState failed: %v", err)
+	}
+	if state.Status != ecco9.DeviceStatusReady {
+		t.Errorf("reloaded device should be ready, got %s", state.Status)
+	}
+
+	emotion, ok := device.(*EmotionDevice)
+	if !ok {
+		t.Fatalf("unexpected device type %T", device)
+	}
+	emotion.mu.RLock()
+	rate := emotion.config.DecayRate
+	emotion.mu.RUnlock()
+	if rate != 0.5 {
+		t.Errorf("new config not applied, decay rate %f", rate)
+	}
+}
+
+func TestReloadUnknownDriverFails(t *testing.T) {
+	platform := ecco9.NewPlatform(nil)
+
+	if err := platform.ReloadDriver("nope", nil); err == nil {
+		t.Error("reloading an unregistered driver should fail")
+	}
+}
+
+func TestReloadDriverSafeUnderConcurrentReaders(t *testing.T) {
+	platform := ecco9.NewPlatform(nil)
+	ctx := context.Background()
+
+	driver := NewEmotionDriver()
+	if err := driver.Load(DefaultEmotionConfig()); err != nil {
+		t.Fatalf("failed to load driver: %v", err)
+	}
+	if err := platform.RegisterDriver(driver); err != nil {
+		t.Fatalf("failed to register driver: %v", err)
+	}
+	for _, device := range driver.ListDevices() {
+		if err := device.Initialize(ctx); err != nil {
+			t.Fatalf("failed to initialize device: %v", err)
+		}
+		if err := platform.RegisterDevice(device); err != nil {
+			t.Fatalf("failed to register device: %v", err)
+		}
+	}
+
+	// Readers mirror what the HTTP handlers and the health monitor do
+	// while ReloadDriver rewrites the registry; run under -race this
+	// catches unguarded map access
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				for range platform.SnapshotDevices() {
+				}
+				platform.GetStatus()
+				platform.GetDevice("emotion0")
+			}
+		}()
+	}
+
+	for i := 0; i < 20; i++ {
+		if err := platform.ReloadDriver("emotion", DefaultEmotionConfig()); err != nil {
+			t.Errorf("reload %d failed: %v", i, err)
+			break
+		}
+	}
+	close(done)
+	wg.Wait()
+
+	if _, err := platform.GetDevice("emotion0"); err != nil {
+		t.Errorf("device should survive repeated reloads: %v", err)
+	}
+}
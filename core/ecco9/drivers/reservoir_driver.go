@@ -128,6 +128,13 @@ func (rd *ReservoirDriver) GetCapabilities() []string {
 	}
 }
 
+// GetConfig exposes the loaded config for platform snapshots
+func (rd *ReservoirDriver) GetConfig() interface{} {
+	rd.mu.RLock()
+	defer rd.mu.RUnlock()
+	return rd.config
+}
+
 // ReservoirDevice represents a single Echo State Reservoir processor
 type ReservoirDevice struct {
 	mu          sync.RWMutex
@@ -0,0 +1,120 @@
+package drivers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/EchoCog/echollama/core/ecco9"
+)
+
+// newSnapshotTestPlatform registers an initialized emotion driver and
+// device on a fresh platform
+func newSnapshotTestPlatform(t *testing.T) (*ecco9.Platform, *EmotionDevice) {
+	t.Helper()
+
+	platform := ecco9.NewPlatform(nil)
+	ctx := context.Background()
+
+	driver := NewEmotionDriver()
+	if err := driver.Load(DefaultEmotionConfig()); err != nil {
+		t.Fatalf("failed to load driver: %v", err)
+	}
+	if err := platform.RegisterDriver(driver); err != nil {
+		t.Fatalf("failed to register driver: %v", err)
+	}
+
+	device, err := driver.GetDevice("emotion0")
+	if err != nil {
+		t.Fatalf("failed to get device: %v", err)
+	}
+	if err := device.Initialize(ctx); err != nil {
+		t.Fatalf("failed to initialize device: %v", err)
+	}
+	if err := platform.RegisterDevice(device); err != nil {
+		t.Fatalf("failed to register device: %v", err)
+	}
+
+	return platform, device.(*EmotionDevice)
+}
+
+func TestSnapshotRestoreRevertsDeviceState(t *testing.T) {
+	platform, device := newSnapshotTestPlatform(t)
+
+	device.mu.Lock()
+	device.arousal = 0.9
+	device.emotions["joy"].Intensity = 0.7
+	device.mu.Unlock()
+
+	snapshot, err := platform.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+	if _, ok := snapshot.DriverConfigs["emotion"]; !ok {
+		t.Error("snapshot should capture the emotion driver config")
+	}
+
+	// Mutate the device, then restore the snapshot
+	device.mu.Lock()
+	device.arousal = 0.1
+	device.emotions["joy"].Intensity = 0.0
+	device.mu.Unlock()
+
+	if err := platform.Restore(snapshot); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+
+	arousal, _ := device.affectState()
+	if arousal != 0.9 {
+		t.Errorf("arousal should revert to 0.9, got %f", arousal)
+	}
+	if intensity := device.channelIntensity("joy"); intensity != 0.7 {
+		t.Errorf("joy should revert to 0.7, got %f", intensity)
+	}
+}
+
+func TestSnapshotRoundTripsThroughJSON(t *testing.T) {
+	platform, device := newSnapshotTestPlatform(t)
+
+	device.mu.Lock()
+	device.valence = 0.8
+	device.mu.Unlock()
+
+	snapshot, err := platform.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+
+	data, err := snapshot.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON failed: %v", err)
+	}
+	parsed, err := ecco9.SnapshotFromJSON(data)
+	if err != nil {
+		t.Fatalf("SnapshotFromJSON failed: %v", err)
+	}
+
+	device.mu.Lock()
+	device.valence = 0.2
+	device.mu.Unlock()
+
+	if err := platform.Restore(parsed); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if _, valence := device.affectState(); valence != 0.8 {
+		t.Errorf("valence should revert through JSON round trip, got %f", valence)
+	}
+}
+
+func TestRestoreSkipsUnknownDevices(t *testing.T) {
+	platform, _ := newSnapshotTestPlatform(t)
+
+	snapshot, err := platform.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+	snapshot.Devices["ghost0"] = ecco9.DeviceState{ID: "ghost0"}
+
+	if err := platform.Restore(snapshot); err != nil {
+		t.Errorf("unknown devices should be skipped, not fail: %v", err)
+	}
+}
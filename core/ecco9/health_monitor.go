@@ -0,0 +1,158 @@
+package ecco9
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// HealthEvent records a device health transition observed by the monitor
+type HealthEvent struct {
+	DeviceID  string
+	Previous  HealthStatus
+	Current   HealthStatus
+	Timestamp time.Time
+}
+
+// HealthMonitor polls every device's health on an interval so callers
+// can rely on cached readings instead of querying devices synchronously
+type HealthMonitor struct {
+	mu       sync.RWMutex
+	interval time.Duration
+	cached   map[string]HealthStatus
+	events   []HealthEvent
+	onEvent  func(HealthEvent)
+	cancel   context.CancelFunc
+}
+
+// NewHealthMonitor creates a health monitor polling at the given interval
+func NewHealthMonitor(interval time.Duration) *HealthMonitor {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	return &HealthMonitor{
+		interval: interval,
+		cached:   make(map[string]HealthStatus),
+	}
+}
+
+// OnHealthChange registers a callback invoked for every health transition
+func (hm *HealthMonitor) OnHealthChange(callback func(HealthEvent)) {
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+	hm.onEvent = callback
+}
+
+// Start launches the background polling loop over the platform's devices
+func (hm *HealthMonitor) Start(ctx context.Context, platform *Platform) {
+	ctx, cancel := context.WithCancel(ctx)
+	hm.mu.Lock()
+	hm.cancel = cancel
+	hm.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(hm.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// Work from a snapshot so a concurrent driver
+				// reload cannot mutate the map mid-range
+				hm.Poll(platform.SnapshotDevices())
+			}
+		}
+	}()
+}
+
+// Stop halts the polling loop; cached readings remain available
+func (hm *HealthMonitor) Stop() {
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+	if hm.cancel != nil {
+		hm.cancel()
+		hm.cancel = nil
+	}
+}
+
+// Poll reads every device's health once, recording transitions and
+// moving devices whose health collapsed into the error status
+func (hm *HealthMonitor) Poll(devices map[string]CognitiveDevice) {
+	for id, device := range devices {
+		health, err := device.GetHealth()
+		if err != nil {
+			continue
+		}
+
+		hm.mu.Lock()
+		previous, seen := hm.cached[id]
+		hm.cached[id] = health
+		changed := seen && previous != health
+		var event HealthEvent
+		if changed {
+			event = HealthEvent{
+				DeviceID:  id,
+				Previous:  previous,
+				Current:   health,
+				Timestamp: time.Now(),
+			}
+			hm.events = append(hm.events, event)
+		}
+		callback := hm.onEvent
+		hm.mu.Unlock()
+
+		if !changed {
+			continue
+		}
+
+		log.Printf("⚠️  Device %s health: %s → %s", id, previous, health)
+
+		// A device whose health collapsed transitions to the error status
+		if health == HealthStatusCritical || health == HealthStatusFailed {
+			if state, err := device.GetState(); err == nil && state.Status != DeviceStatusError {
+				state.Status = DeviceStatusError
+				if err := device.SetState(state); err != nil {
+					log.Printf("   Failed to update %s status: %v", id, err)
+				}
+			}
+		}
+
+		if callback != nil {
+			callback(event)
+		}
+	}
+}
+
+// GetCachedHealth returns the last polled health for a device
+func (hm *HealthMonitor) GetCachedHealth(deviceID string) (HealthStatus, bool) {
+	hm.mu.RLock()
+	defer hm.mu.RUnlock()
+
+	health, exists := hm.cached[deviceID]
+	return health, exists
+}
+
+// Snapshot returns a copy of all cached health readings
+func (hm *HealthMonitor) Snapshot() map[string]HealthStatus {
+	hm.mu.RLock()
+	defer hm.mu.RUnlock()
+
+	snapshot := make(map[string]HealthStatus, len(hm.cached))
+	for id, health := range hm.cached {
+		snapshot[id] = health
+	}
+	return snapshot
+}
+
+// Events returns the recorded health transitions in order
+func (hm *HealthMonitor) Events() []HealthEvent {
+	hm.mu.RLock()
+	defer hm.mu.RUnlock()
+
+	events := make([]HealthEvent, len(hm.events))
+	copy(events, hm.events)
+	return events
+}
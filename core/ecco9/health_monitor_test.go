@@ -0,0 +1,141 @@
+package ecco9
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakyDevice is a stubDevice whose health can be flipped mid-test
+type flakyDevice struct {
+	stubDevice
+	mu     sync.Mutex
+	health HealthStatus
+	state  DeviceState
+}
+
+func (d *flakyDevice) GetHealth() (HealthStatus, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.health, nil
+}
+
+func (d *flakyDevice) setHealth(health HealthStatus) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.health = health
+}
+
+func (d *flakyDevice) GetState() (DeviceState, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state, nil
+}
+
+func (d *flakyDevice) SetState(state DeviceState) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.state = state
+	return nil
+}
+
+func (d *flakyDevice) status() DeviceStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.state.Status
+}
+
+func TestHealthMonitorObservesDegradation(t *testing.T) {
+	device := &flakyDevice{
+		stubDevice: stubDevice{id: "flaky"},
+		health:     HealthStatusHealthy,
+		state:      DeviceState{ID: "flaky", Status: DeviceStatusReady},
+	}
+	devices := map[string]CognitiveDevice{"flaky": device}
+
+	monitor := NewHealthMonitor(time.Second)
+	var events []HealthEvent
+	var mu sync.Mutex
+	monitor.OnHealthChange(func(event HealthEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	})
+
+	// The first poll seeds the cache without emitting events
+	monitor.Poll(devices)
+	if health, ok := monitor.GetCachedHealth("flaky"); !ok || health != HealthStatusHealthy {
+		t.Fatalf("cached health wrong after first poll: %s", health)
+	}
+	if len(monitor.Events()) != 0 {
+		t.Fatal("first poll should not emit events")
+	}
+
+	// The device silently degrades; the next poll observes it
+	device.setHealth(HealthStatusCritical)
+	monitor.Poll(devices)
+
+	if health, _ := monitor.GetCachedHealth("flaky"); health != HealthStatusCritical {
+		t.Errorf("cached health not updated: %s", health)
+	}
+
+	recorded := monitor.Events()
+	if len(recorded) != 1 {
+		t.Fatalf("expected one transition event, got %d", len(recorded))
+	}
+	if recorded[0].Previous != HealthStatusHealthy || recorded[0].Current != HealthStatusCritical {
+		t.Errorf("event transition wrong: %s → %s", recorded[0].Previous, recorded[0].Current)
+	}
+
+	mu.Lock()
+	callbackCount := len(events)
+	mu.Unlock()
+	if callbackCount != 1 {
+		t.Errorf("callback should fire once, got %d", callbackCount)
+	}
+
+	// Collapsed health moves the device into the error status
+	if status := device.status(); status != DeviceStatusError {
+		t.Errorf("critical device should transition to error status, got %s", status)
+	}
+
+	// A steady reading emits nothing further
+	monitor.Poll(devices)
+	if len(monitor.Events()) != 1 {
+		t.Error("unchanged health should not emit more events")
+	}
+}
+
+func TestHealthMonitorBackgroundPolling(t *testing.T) {
+	config := DefaultConfiguration()
+	config.Telemetry.CollectionRate = 20 * time.Millisecond
+	platform := NewPlatform(config)
+
+	device := &flakyDevice{
+		stubDevice: stubDevice{id: "flaky"},
+		health:     HealthStatusHealthy,
+		state:      DeviceState{ID: "flaky", Status: DeviceStatusReady},
+	}
+	if err := platform.RegisterDevice(device); err != nil {
+		t.Fatalf("failed to register device: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := platform.Boot(ctx); err != nil {
+		t.Fatalf("failed to boot platform: %v", err)
+	}
+	defer platform.Health.Stop()
+
+	device.setHealth(HealthStatusCritical)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if health, _ := platform.Health.GetCachedHealth("flaky"); health == HealthStatusCritical {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("background monitor never observed the degraded device")
+}
@@ -20,6 +20,7 @@ func NewPlatform(config *Configuration) *Platform {
 		Firmware:  NewFirmware(),
 		Streams:   NewStreamManager(),
 		Telemetry: NewTelemetryCollector(),
+		Health:    NewHealthMonitor(config.Telemetry.CollectionRate),
 		Config:    config,
 	}
 }
@@ -51,6 +52,10 @@ func DefaultConfiguration() *Configuration {
 			CollectionRate: 5 * time.Second,
 			RetentionDays:  30,
 		},
+		RateLimit: RateLimitConfig{
+			GenerateRPS:   5,
+			GenerateBurst: 10,
+		},
 	}
 }
 
@@ -75,7 +80,10 @@ func NewStreamManager() *StreamManager {
 // NewTelemetryCollector creates a new telemetry collector
 func NewTelemetryCollector() *TelemetryCollector {
 	return &TelemetryCollector{
-		Metrics: make(map[string]interface{}),
+		Metrics:       make(map[string]interface{}),
+		Concurrency:   4,
+		DeviceTimeout: 2 * time.Second,
+		lastReadings:  make(map[string]*DeviceTelemetry),
 	}
 }
 
@@ -115,8 +123,12 @@ func (p *Platform) Boot(ctx context.Context) error {
 	
 	p.Firmware.BootStage = BootStageReady
 	p.BootTime = time.Now() // Record boot time
+
+	// Start the background health monitor
+	p.Health.Start(ctx, p)
+
 	log.Println("✨ ecco9 Platform Boot Complete - All Systems Ready")
-	
+
 	return nil
 }
 
@@ -203,10 +215,13 @@ func (p *Platform) bootStage5(ctx context.Context) error {
 // Shutdown gracefully shuts down the platform
 func (p *Platform) Shutdown(ctx context.Context) error {
 	log.Println("🌙 Shutting down ecco9 Platform...")
-	
+
+	// Stop the background health monitor
+	p.Health.Stop()
+
 	// Shutdown all devices
 	var wg sync.WaitGroup
-	for id, device := range p.Devices {
+	for id, device := range p.SnapshotDevices() {
 		wg.Add(1)
 		go func(id string, dev CognitiveDevice) {
 			defer wg.Done()
@@ -232,6 +247,9 @@ func (p *Platform) Shutdown(ctx context.Context) error {
 
 // RegisterDevice adds a device to the platform
 func (p *Platform) RegisterDevice(device CognitiveDevice) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	id := device.GetID()
 	if _, exists := p.Devices[id]; exists {
 		return fmt.Errorf("device %s already registered", id)
@@ -244,6 +262,9 @@ func (p *Platform) RegisterDevice(device CognitiveDevice) error {
 
 // RegisterDriver adds a driver to the platform
 func (p *Platform) RegisterDriver(driver Driver) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	name := driver.GetName()
 	if _, exists := p.Drivers[name]; exists {
 		return fmt.Errorf("driver %s already registered", name)
@@ -254,8 +275,53 @@ func (p *Platform) RegisterDriver(driver Driver) error {
 	return nil
 }
 
+// ReloadDriver unloads a registered driver, re-loads it with the new
+// config, and re-registers its devices, without restarting the platform.
+// Device IDs are preserved when the driver recreates the same devices.
+func (p *Platform) ReloadDriver(name string, config interface{}) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	driver, exists := p.Drivers[name]
+	if !exists {
+		return fmt.Errorf("driver %s not found", name)
+	}
+
+	log.Printf("🔄 Reloading driver: %s", name)
+
+	// Drop the driver's current devices from the platform registry
+	for _, device := range driver.ListDevices() {
+		delete(p.Devices, device.GetID())
+	}
+
+	// Unload shuts down the driver's devices
+	if err := driver.Unload(); err != nil {
+		return fmt.Errorf("failed to unload driver %s: %w", name, err)
+	}
+
+	if err := driver.Load(config); err != nil {
+		return fmt.Errorf("failed to reload driver %s: %w", name, err)
+	}
+
+	// Bring the recreated devices back online
+	ctx := context.Background()
+	for _, device := range driver.ListDevices() {
+		if err := device.Initialize(ctx); err != nil {
+			return fmt.Errorf("failed to initialize device %s: %w", device.GetID(), err)
+		}
+		p.Devices[device.GetID()] = device
+		log.Printf("📍 Re-registered device: %s (%s)", device.GetName(), device.GetType())
+	}
+
+	log.Printf("✅ Driver %s reloaded", name)
+	return nil
+}
+
 // GetDevice retrieves a device by ID
 func (p *Platform) GetDevice(id string) (CognitiveDevice, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	device, exists := p.Devices[id]
 	if !exists {
 		return nil, fmt.Errorf("device %s not found", id)
@@ -265,6 +331,9 @@ func (p *Platform) GetDevice(id string) (CognitiveDevice, error) {
 
 // GetDriver retrieves a driver by name
 func (p *Platform) GetDriver(name string) (Driver, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	driver, exists := p.Drivers[name]
 	if !exists {
 		return nil, fmt.Errorf("driver %s not found", name)
@@ -272,14 +341,45 @@ func (p *Platform) GetDriver(name string) (Driver, error) {
 	return driver, nil
 }
 
+// SnapshotDevices returns a copy of the device registry taken under the
+// platform lock, safe to range while drivers reload concurrently
+func (p *Platform) SnapshotDevices() map[string]CognitiveDevice {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	devices := make(map[string]CognitiveDevice, len(p.Devices))
+	for id, device := range p.Devices {
+		devices[id] = device
+	}
+	return devices
+}
+
+// SnapshotDrivers returns a copy of the driver registry taken under the
+// platform lock
+func (p *Platform) SnapshotDrivers() map[string]Driver {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	drivers := make(map[string]Driver, len(p.Drivers))
+	for name, driver := range p.Drivers {
+		drivers[name] = driver
+	}
+	return drivers
+}
+
 // GetStatus returns the overall platform status
 func (p *Platform) GetStatus() map[string]interface{} {
+	p.mu.RLock()
+	deviceCount := len(p.Devices)
+	driverCount := len(p.Drivers)
+	p.mu.RUnlock()
+
 	return map[string]interface{}{
 		"firmware_version": p.Firmware.Version,
 		"kernel_version":   p.Firmware.KernelVersion,
 		"boot_stage":       p.Firmware.BootStage.String(),
-		"device_count":     len(p.Devices),
-		"driver_count":     len(p.Drivers),
+		"device_count":     deviceCount,
+		"driver_count":     driverCount,
 		"uptime":           time.Since(p.BootTime),
 		"ports":            p.Config.Ports,
 	}
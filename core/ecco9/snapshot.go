@@ -0,0 +1,85 @@
+package ecco9
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+// PlatformSnapshot captures every device's state and the configs of
+// drivers that expose them, for reproducible debugging
+type PlatformSnapshot struct {
+	Timestamp     time.Time
+	Devices       map[string]DeviceState
+	DriverConfigs map[string]interface{}
+}
+
+// ConfigProvider is an optional driver interface for exposing the
+// currently loaded config in snapshots
+type ConfigProvider interface {
+	GetConfig() interface{}
+}
+
+// Snapshot collects the state of every registered device plus driver
+// configs where available
+func (p *Platform) Snapshot() (PlatformSnapshot, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	snapshot := PlatformSnapshot{
+		Timestamp:     time.Now(),
+		Devices:       make(map[string]DeviceState, len(p.Devices)),
+		DriverConfigs: make(map[string]interface{}),
+	}
+
+	for id, device := range p.Devices {
+		state, err := device.GetState()
+		if err != nil {
+			return PlatformSnapshot{}, fmt.Errorf("failed to capture state of device %s: %w", id, err)
+		}
+		snapshot.Devices[id] = state
+	}
+
+	for name, driver := range p.Drivers {
+		if provider, ok := driver.(ConfigProvider); ok {
+			snapshot.DriverConfigs[name] = provider.GetConfig()
+		}
+	}
+
+	return snapshot, nil
+}
+
+// Restore applies a snapshot's device states. Devices that no longer
+// exist are skipped with a warning.
+func (p *Platform) Restore(snapshot PlatformSnapshot) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id, state := range snapshot.Devices {
+		device, exists := p.Devices[id]
+		if !exists {
+			log.Printf("⚠️  Skipping snapshot state for unknown device: %s", id)
+			continue
+		}
+		if err := device.SetState(state); err != nil {
+			return fmt.Errorf("failed to restore device %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// ToJSON serializes the snapshot
+func (s PlatformSnapshot) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// SnapshotFromJSON deserializes a snapshot
+func SnapshotFromJSON(data []byte) (PlatformSnapshot, error) {
+	var snapshot PlatformSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return PlatformSnapshot{}, fmt.Errorf("failed to parse snapshot: %w", err)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,110 @@
+package ecco9
+
+import (
+	"sync"
+	"time"
+)
+
+// Collect polls every device's metrics and health concurrently using a
+// bounded worker pool. A device that does not respond within
+// DeviceTimeout is marked stale (reusing its previous reading if one
+// exists) rather than blocking the whole collection.
+func (tc *TelemetryCollector) Collect(devices map[string]CognitiveDevice) map[string]*DeviceTelemetry {
+	concurrency := tc.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	timeout := tc.DeviceTimeout
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+
+	readings := make(map[string]*DeviceTelemetry, len(devices))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for id, device := range devices {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id string, device CognitiveDevice) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			reading := tc.pollDevice(id, device, timeout)
+
+			mu.Lock()
+			readings[id] = reading
+			mu.Unlock()
+		}(id, device)
+	}
+
+	wg.Wait()
+
+	tc.mu.Lock()
+	for id, reading := range readings {
+		tc.lastReadings[id] = reading
+	}
+	tc.mu.Unlock()
+
+	return readings
+}
+
+// pollDevice reads one device with a timeout. The read runs in its own
+// goroutine so a blocked device cannot stall collection; if it times
+// out, the previous reading (when available) is returned marked stale.
+func (tc *TelemetryCollector) pollDevice(id string, device CognitiveDevice, timeout time.Duration) *DeviceTelemetry {
+	type pollResult struct {
+		metrics DeviceMetrics
+		health  HealthStatus
+		err     error
+	}
+
+	resultChan := make(chan pollResult, 1)
+	go func() {
+		metrics, err := device.GetMetrics()
+		if err != nil {
+			resultChan <- pollResult{err: err}
+			return
+		}
+		health, err := device.GetHealth()
+		resultChan <- pollResult{metrics: metrics, health: health, err: err}
+	}()
+
+	select {
+	case result := <-resultChan:
+		return &DeviceTelemetry{
+			DeviceID:    id,
+			Metrics:     result.metrics,
+			Health:      result.health,
+			Stale:       result.err != nil,
+			Error:       result.err,
+			CollectedAt: time.Now(),
+		}
+	case <-time.After(timeout):
+		// Reuse the last good reading if we have one, but flag it stale
+		tc.mu.RLock()
+		previous := tc.lastReadings[id]
+		tc.mu.RUnlock()
+
+		stale := &DeviceTelemetry{
+			DeviceID:    id,
+			Stale:       true,
+			CollectedAt: time.Now(),
+		}
+		if previous != nil {
+			stale.Metrics = previous.Metrics
+			stale.Health = previous.Health
+		}
+		return stale
+	}
+}
+
+// GetLastReading returns the most recent telemetry reading for a device
+func (tc *TelemetryCollector) GetLastReading(deviceID string) (*DeviceTelemetry, bool) {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+
+	reading, exists := tc.lastReadings[deviceID]
+	return reading, exists
+}
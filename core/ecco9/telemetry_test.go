@@ -0,0 +1,87 @@
+package ecco9
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// stubDevice is a minimal CognitiveDevice for telemetry tests. Delay
+// simulates a device whose metric reads block (e.g. holding a lock).
+type stubDevice struct {
+	id    string
+	delay time.Duration
+}
+
+func (d *stubDevice) Initialize(ctx context.Context) error { return nil }
+func (d *stubDevice) Shutdown(ctx context.Context) error   { return nil }
+func (d *stubDevice) Reset(ctx context.Context) error      { return nil }
+func (d *stubDevice) GetState() (DeviceState, error)       { return DeviceState{ID: d.id}, nil }
+func (d *stubDevice) SetState(DeviceState) error           { return nil }
+func (d *stubDevice) Read(buffer []byte) (int, error)      { return 0, nil }
+func (d *stubDevice) Write(buffer []byte) (int, error)     { return len(buffer), nil }
+func (d *stubDevice) IoCtl(command uint32, arg interface{}) error { return nil }
+func (d *stubDevice) GetID() string                        { return d.id }
+func (d *stubDevice) GetName() string                      { return d.id }
+func (d *stubDevice) GetType() DeviceType                  { return DeviceTypeControl }
+
+func (d *stubDevice) GetMetrics() (DeviceMetrics, error) {
+	time.Sleep(d.delay)
+	return DeviceMetrics{OperationCount: 42}, nil
+}
+
+func (d *stubDevice) GetHealth() (HealthStatus, error) {
+	return HealthStatusHealthy, nil
+}
+
+func TestCollectFlagsSlowDeviceStale(t *testing.T) {
+	tc := NewTelemetryCollector()
+	tc.DeviceTimeout = 50 * time.Millisecond
+
+	devices := map[string]CognitiveDevice{
+		"fast": &stubDevice{id: "fast"},
+		"slow": &stubDevice{id: "slow", delay: 5 * time.Second},
+	}
+
+	start := time.Now()
+	readings := tc.Collect(devices)
+	elapsed := time.Since(start)
+
+	if elapsed > time.Second {
+		t.Errorf("collection should not block on the slow device, took %v", elapsed)
+	}
+
+	if len(readings) != 2 {
+		t.Fatalf("expected readings for both devices, got %d", len(readings))
+	}
+
+	if readings["fast"].Stale {
+		t.Error("fast device should not be stale")
+	}
+	if readings["fast"].Metrics.OperationCount != 42 {
+		t.Error("fast device metrics not collected")
+	}
+
+	if !readings["slow"].Stale {
+		t.Error("slow device should be flagged stale")
+	}
+}
+
+func TestCollectConcurrencyBound(t *testing.T) {
+	tc := NewTelemetryCollector()
+	tc.Concurrency = 2
+	tc.DeviceTimeout = time.Second
+
+	devices := make(map[string]CognitiveDevice)
+	for _, id := range []string{"a", "b", "c", "d"} {
+		devices[id] = &stubDevice{id: id, delay: 20 * time.Millisecond}
+	}
+
+	readings := tc.Collect(devices)
+
+	for id, reading := range readings {
+		if reading.Stale {
+			t.Errorf("device %s should not be stale", id)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package ecco9
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
@@ -15,6 +16,7 @@ type DeviceState struct {
 	Metrics     DeviceMetrics
 	LastUpdate  time.Time
 	Uptime      time.Duration
+	Attributes  map[string]interface{} // Device-specific state for snapshot/restore
 }
 
 // DeviceStatus represents the operational status of a device
@@ -103,11 +105,16 @@ const (
 
 // Platform represents the ecco9 cognitive hardware platform
 type Platform struct {
+	// mu guards the device/driver registries. ReloadDriver mutates
+	// both maps at runtime, so concurrent readers must hold the read
+	// lock or work from a Snapshot* copy.
+	mu           sync.RWMutex
 	Devices      map[string]CognitiveDevice
 	Drivers      map[string]Driver
 	Firmware     *Firmware
 	Streams      *StreamManager
 	Telemetry    *TelemetryCollector
+	Health       *HealthMonitor
 	Config       *Configuration
 	BootTime     time.Time // When platform was booted
 }
@@ -174,6 +181,7 @@ type Configuration struct {
 	Memory        MemoryConfig
 	Performance   PerformanceConfig
 	Telemetry     TelemetryConfig
+	RateLimit     RateLimitConfig
 }
 
 // PortConfig defines port allocation
@@ -229,6 +237,12 @@ type TelemetryConfig struct {
 	RetentionDays  int
 }
 
+// RateLimitConfig defines per-client API rate limits
+type RateLimitConfig struct {
+	GenerateRPS   float64 // Sustained generate requests per second per client (0 disables)
+	GenerateBurst int     // Burst allowance per client
+}
+
 // StreamManager manages I/O streams
 type StreamManager struct {
 	InputStreams   map[string]Stream
@@ -245,4 +259,23 @@ type Stream interface {
 // TelemetryCollector gathers platform metrics
 type TelemetryCollector struct {
 	Metrics map[string]interface{}
+
+	// Concurrency bounds how many devices are polled in parallel
+	Concurrency int
+	// DeviceTimeout is how long to wait on a single device before
+	// marking its telemetry stale
+	DeviceTimeout time.Duration
+
+	mu          sync.RWMutex
+	lastReadings map[string]*DeviceTelemetry
+}
+
+// DeviceTelemetry is one polled reading from a device
+type DeviceTelemetry struct {
+	DeviceID    string
+	Metrics     DeviceMetrics
+	Health      HealthStatus
+	Stale       bool
+	Error       error
+	CollectedAt time.Time
 }
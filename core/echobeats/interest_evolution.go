@@ -3,19 +3,25 @@ package echobeats
 import (
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/persistence"
+	"github.com/EchoCog/echollama/core/randutil"
 )
 
 // InterestEvolutionEngine handles adaptive learning and evolution of interest patterns
 type InterestEvolutionEngine struct {
-	mu sync.RWMutex
+	mu  sync.RWMutex
+	rng *randutil.Source
 
 	// Evolution parameters
 	reinforcementRate float64
 	extinctionRate    float64
 	mutationRate      float64
 	crossoverRate     float64
+	reinforcement     ReinforcementConfig
 
 	// Learning history
 	engagementOutcomes map[string]*EngagementOutcome
@@ -66,9 +72,65 @@ type InterestCluster struct {
 	LastUpdated   time.Time
 }
 
+// ReinforcementConfig weights the composite reward and the knock-on
+// salience/arousal updates in ApplyReinforcement
+type ReinforcementConfig struct {
+	LearningGainWeight float64 // Learning gain's share of the composite reward
+	SatisfactionWeight float64 // Satisfaction's share of the composite reward
+	NoveltyWeight      float64 // Novelty's share of the composite reward
+	SalienceMultiplier float64 // How strongly reward lifts salience
+	ArousalMultiplier  float64 // How strongly novelty lifts arousal
+}
+
+// DefaultReinforcementConfig preserves the engine's historical weights
+func DefaultReinforcementConfig() ReinforcementConfig {
+	return ReinforcementConfig{
+		LearningGainWeight: 0.3,
+		SatisfactionWeight: 0.2,
+		NoveltyWeight:      0.15,
+		SalienceMultiplier: 0.5,
+		ArousalMultiplier:  0.3,
+	}
+}
+
+// Validate checks that all weights are non-negative
+func (c ReinforcementConfig) Validate() error {
+	if c.LearningGainWeight < 0 || c.SatisfactionWeight < 0 || c.NoveltyWeight < 0 ||
+		c.SalienceMultiplier < 0 || c.ArousalMultiplier < 0 {
+		return fmt.Errorf("reinforcement weights must be non-negative: %+v", c)
+	}
+	return nil
+}
+
+// SetReinforcementConfig replaces the reward weighting; invalid configs
+// are rejected and the current weights stand
+func (iee *InterestEvolutionEngine) SetReinforcementConfig(config ReinforcementConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	iee.mu.Lock()
+	defer iee.mu.Unlock()
+	iee.reinforcement = config
+	return nil
+}
+
 // NewInterestEvolutionEngine creates a new interest evolution engine
+// drawing randomness from the process-wide source
 func NewInterestEvolutionEngine() *InterestEvolutionEngine {
+	return newInterestEvolutionEngine(randutil.Default())
+}
+
+// NewInterestEvolutionEngineWithSeed creates an engine whose mutation
+// decisions and magnitudes are reproducible for a given seed
+func NewInterestEvolutionEngineWithSeed(seed int64) *InterestEvolutionEngine {
+	return newInterestEvolutionEngine(randutil.New(seed))
+}
+
+func newInterestEvolutionEngine(rng *randutil.Source) *InterestEvolutionEngine {
 	return &InterestEvolutionEngine{
+		rng:                rng,
+		reinforcement:      DefaultReinforcementConfig(),
 		reinforcementRate:  0.1,
 		extinctionRate:     0.05,
 		mutationRate:       0.15,
@@ -80,6 +142,83 @@ func NewInterestEvolutionEngine() *InterestEvolutionEngine {
 	}
 }
 
+// interestEvolutionStateKey is the state key the engine persists under
+const interestEvolutionStateKey = "interest_evolution_state"
+
+// interestEvolutionState is the envelope saved through the store's
+// key-value state API
+type interestEvolutionState struct {
+	EngagementOutcomes map[string]*EngagementOutcome `json:"engagement_outcomes"`
+	RewardHistory      []RewardSignal                `json:"reward_history"`
+	DiscoveredPatterns map[string]*InterestCluster   `json:"discovered_patterns"`
+	EmergentInterests  []*Interest                   `json:"emergent_interests"`
+	TotalEvolutions    uint64                        `json:"total_evolutions"`
+	TotalMutations     uint64                        `json:"total_mutations"`
+	TotalCrossovers    uint64                        `json:"total_crossovers"`
+}
+
+// Save persists the engine's learning state and evolution counters so
+// reinforcement learning resumes across restarts
+func (iee *InterestEvolutionEngine) Save(store persistence.Store) error {
+	iee.mu.RLock()
+
+	state := interestEvolutionState{
+		EngagementOutcomes: make(map[string]*EngagementOutcome, len(iee.engagementOutcomes)),
+		RewardHistory:      append([]RewardSignal(nil), iee.rewardHistory...),
+		DiscoveredPatterns: make(map[string]*InterestCluster, len(iee.discoveredPatterns)),
+		EmergentInterests:  append([]*Interest(nil), iee.emergentInterests...),
+		TotalEvolutions:    iee.totalEvolutions,
+		TotalMutations:     iee.totalMutations,
+		TotalCrossovers:    iee.totalCrossovers,
+	}
+	for id, outcome := range iee.engagementOutcomes {
+		copied := *outcome
+		state.EngagementOutcomes[id] = &copied
+	}
+	for id, cluster := range iee.discoveredPatterns {
+		copied := *cluster
+		state.DiscoveredPatterns[id] = &copied
+	}
+
+	iee.mu.RUnlock()
+
+	if err := store.SaveState(interestEvolutionStateKey, state); err != nil {
+		return fmt.Errorf("failed to save interest evolution state: %w", err)
+	}
+
+	return nil
+}
+
+// Load restores the learning state saved by Save, letting Q-learning
+// style strengths pick up where the previous run left off
+func (iee *InterestEvolutionEngine) Load(store persistence.Store) error {
+	var state interestEvolutionState
+	if err := store.GetState(interestEvolutionStateKey, &state); err != nil {
+		return fmt.Errorf("failed to load interest evolution state: %w", err)
+	}
+
+	iee.mu.Lock()
+	defer iee.mu.Unlock()
+
+	if state.EngagementOutcomes != nil {
+		iee.engagementOutcomes = state.EngagementOutcomes
+	}
+	if state.RewardHistory != nil {
+		iee.rewardHistory = state.RewardHistory
+	}
+	if state.DiscoveredPatterns != nil {
+		iee.discoveredPatterns = state.DiscoveredPatterns
+	}
+	if state.EmergentInterests != nil {
+		iee.emergentInterests = state.EmergentInterests
+	}
+	iee.totalEvolutions = state.TotalEvolutions
+	iee.totalMutations = state.TotalMutations
+	iee.totalCrossovers = state.TotalCrossovers
+
+	return nil
+}
+
 // ApplyReinforcement adjusts interest strength based on engagement outcomes
 func (iee *InterestEvolutionEngine) ApplyReinforcement(interest *Interest, outcome *EngagementOutcome) {
 	iee.mu.Lock()
@@ -90,9 +229,9 @@ func (iee *InterestEvolutionEngine) ApplyReinforcement(interest *Interest, outco
 
 	// Calculate total reward
 	totalReward := outcome.Reward +
-		(outcome.LearningGain * 0.3) +
-		(outcome.Satisfaction * 0.2) +
-		(outcome.NoveltyValue * 0.15)
+		(outcome.LearningGain * iee.reinforcement.LearningGainWeight) +
+		(outcome.Satisfaction * iee.reinforcement.SatisfactionWeight) +
+		(outcome.NoveltyValue * iee.reinforcement.NoveltyWeight)
 
 	// Apply reinforcement learning update
 	// Q-learning inspired: new_value = old_value + learning_rate * (reward + discount * max_future_value - old_value)
@@ -101,8 +240,8 @@ func (iee *InterestEvolutionEngine) ApplyReinforcement(interest *Interest, outco
 	// Positive reinforcement
 	if totalReward > 0 {
 		interest.Strength = interest.Strength + learningRate*totalReward*(1.0-interest.Strength)
-		interest.Salience += learningRate * totalReward * 0.5
-		interest.Arousal += learningRate * outcome.NoveltyValue * 0.3
+		interest.Salience += learningRate * totalReward * iee.reinforcement.SalienceMultiplier
+		interest.Arousal += learningRate * outcome.NoveltyValue * iee.reinforcement.ArousalMultiplier
 	} else {
 		// Negative reinforcement (extinction)
 		extinctionFactor := iee.extinctionRate * math.Abs(totalReward)
@@ -130,7 +269,10 @@ func (iee *InterestEvolutionEngine) ApplyReinforcement(interest *Interest, outco
 	iee.totalEvolutions++
 }
 
-// DiscoverClusters finds related interest patterns through clustering
+// DiscoverClusters finds related interest patterns by k-means clustering
+// over interest feature vectors. The cluster count grows until every
+// member sits within kMeansRadius of its centroid, so well-separated
+// interest groups come out as distinct clusters.
 func (iee *InterestEvolutionEngine) DiscoverClusters(interests []*Interest) []*InterestCluster {
 	iee.mu.Lock()
 	defer iee.mu.Unlock()
@@ -139,47 +281,56 @@ func (iee *InterestEvolutionEngine) DiscoverClusters(interests []*Interest) []*I
 		return nil
 	}
 
-	// Simple clustering based on shared topics and engagement patterns
-	clusters := make([]*InterestCluster, 0)
-	visited := make(map[string]bool)
+	vocabulary := topicVocabulary(interests)
+	vectors := make([][]float64, len(interests))
+	for i, interest := range interests {
+		vectors[i] = interestFeatureVector(interest, vocabulary)
+	}
 
-	for _, interest := range interests {
-		if visited[interest.ID] {
+	assignments, centroids := clusterByKMeans(vectors)
+
+	// Group members by final assignment
+	members := make(map[int][]*Interest)
+	memberVectors := make(map[int][][]float64)
+	for i, c := range assignments {
+		members[c] = append(members[c], interests[i])
+		memberVectors[c] = append(memberVectors[c], vectors[i])
+	}
+
+	clusters := make([]*InterestCluster, 0)
+	for c := range centroids {
+		// Only keep clusters with multiple members
+		if len(members[c]) < 2 {
 			continue
 		}
 
 		cluster := &InterestCluster{
 			ID:            generateClusterID(),
-			CoreTopic:     interest.Name,
 			RelatedTopics: make([]string, 0),
-			Members:       []*Interest{interest},
+			Members:       members[c],
+			Centroid:      centroids[c],
 			CreatedAt:     time.Now(),
 			LastUpdated:   time.Now(),
 		}
 
-		visited[interest.ID] = true
-
-		// Find related interests
-		for _, other := range interests {
-			if visited[other.ID] {
-				continue
+		// The strongest member anchors the cluster's topic
+		core := members[c][0]
+		for _, member := range members[c] {
+			if member.Strength > core.Strength {
+				core = member
 			}
-
-			similarity := calculateInterestSimilarity(interest, other)
-			if similarity > 0.6 {
-				cluster.Members = append(cluster.Members, other)
-				cluster.RelatedTopics = append(cluster.RelatedTopics, other.Name)
-				visited[other.ID] = true
+		}
+		cluster.CoreTopic = core.Name
+		for _, member := range members[c] {
+			if member != core {
+				cluster.RelatedTopics = append(cluster.RelatedTopics, member.Name)
 			}
 		}
 
-		// Only keep clusters with multiple members
-		if len(cluster.Members) > 1 {
-			cluster.Strength = calculateClusterStrength(cluster)
-			cluster.Coherence = calculateClusterCoherence(cluster)
-			clusters = append(clusters, cluster)
-			iee.discoveredPatterns[cluster.ID] = cluster
-		}
+		cluster.Strength = calculateClusterStrength(cluster)
+		cluster.Coherence = centroidCoherence(memberVectors[c], centroids[c])
+		clusters = append(clusters, cluster)
+		iee.discoveredPatterns[cluster.ID] = cluster
 	}
 
 	return clusters
@@ -206,14 +357,14 @@ func (iee *InterestEvolutionEngine) GenerateEmergentInterest(parent1, parent2 *I
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 		LastEngaged:   time.Now(),
-		RelatedTopics: append(parent1.RelatedTopics, parent2.RelatedTopics...),
-		Tags:          append(parent1.Tags, parent2.Tags...),
+		RelatedTopics: mergeUnique(parent1.RelatedTopics, parent2.RelatedTopics),
+		Tags:          mergeUnique(parent1.Tags, parent2.Tags),
 		Metadata:      make(map[string]interface{}),
 	}
 
 	// Apply mutation
-	if shouldMutate(iee.mutationRate) {
-		applyMutation(emergent)
+	if iee.shouldMutate() {
+		iee.applyMutation(emergent)
 		iee.totalMutations++
 	}
 
@@ -268,71 +419,234 @@ func calculateGrowthRate(interest *Interest, outcome *EngagementOutcome) float64
 	return math.Min(1.0, (baseGrowth+noveltyBonus)*competenceFactor)
 }
 
-func calculateInterestSimilarity(i1, i2 *Interest) float64 {
-	// Simple similarity based on shared topics and categories
-	similarity := 0.0
+func calculateClusterStrength(cluster *InterestCluster) float64 {
+	if len(cluster.Members) == 0 {
+		return 0.0
+	}
 
-	// Category match
-	if i1.Category == i2.Category {
-		similarity += 0.3
+	totalStrength := 0.0
+	for _, member := range cluster.Members {
+		totalStrength += member.Strength
 	}
 
-	// Shared related topics
-	sharedTopics := 0
-	for _, t1 := range i1.RelatedTopics {
-		for _, t2 := range i2.RelatedTopics {
-			if t1 == t2 {
-				sharedTopics++
-			}
+	return totalStrength / float64(len(cluster.Members))
+}
+
+// Feature-space clustering
+
+const (
+	// kMeansMaxIterations bounds a single k-means run
+	kMeansMaxIterations = 50
+
+	// kMeansRadius is the largest member-to-centroid distance accepted
+	// before another cluster is added
+	kMeansRadius = 0.75
+
+	// topicFeatureWeight scales the topic one-hot dimensions relative to
+	// the scalar interest features
+	topicFeatureWeight = 0.5
+)
+
+// topicVocabulary collects the sorted union of related topics so every
+// interest maps onto the same one-hot dimensions
+func topicVocabulary(interests []*Interest) []string {
+	seen := make(map[string]bool)
+	for _, interest := range interests {
+		for _, topic := range interest.RelatedTopics {
+			seen[topic] = true
 		}
 	}
 
-	if len(i1.RelatedTopics) > 0 && len(i2.RelatedTopics) > 0 {
-		topicSim := float64(sharedTopics) / float64(len(i1.RelatedTopics)+len(i2.RelatedTopics))
-		similarity += topicSim * 0.4
+	vocabulary := make([]string, 0, len(seen))
+	for topic := range seen {
+		vocabulary = append(vocabulary, topic)
 	}
+	sort.Strings(vocabulary)
+	return vocabulary
+}
 
-	// Strength similarity
-	strengthSim := 1.0 - math.Abs(i1.Strength-i2.Strength)
-	similarity += strengthSim * 0.3
+// interestFeatureVector embeds an interest as scalar features followed
+// by weighted topic one-hots
+func interestFeatureVector(interest *Interest, vocabulary []string) []float64 {
+	vector := make([]float64, 0, 5+len(vocabulary))
+	vector = append(vector,
+		interest.Strength,
+		interest.Salience,
+		interest.Valence,
+		interest.Arousal,
+		interest.Competence,
+	)
+
+	topics := make(map[string]bool, len(interest.RelatedTopics))
+	for _, topic := range interest.RelatedTopics {
+		topics[topic] = true
+	}
+	for _, topic := range vocabulary {
+		if topics[topic] {
+			vector = append(vector, topicFeatureWeight)
+		} else {
+			vector = append(vector, 0.0)
+		}
+	}
 
-	return similarity
+	return vector
 }
 
-func calculateClusterStrength(cluster *InterestCluster) float64 {
-	if len(cluster.Members) == 0 {
-		return 0.0
+// clusterByKMeans grows k until every vector sits within kMeansRadius of
+// its centroid, returning the final assignments and centroids
+func clusterByKMeans(vectors [][]float64) ([]int, [][]float64) {
+	var assignments []int
+	var centroids [][]float64
+
+	for k := 1; k <= len(vectors); k++ {
+		assignments, centroids = runKMeans(vectors, k)
+		if maxCentroidDistance(vectors, assignments, centroids) <= kMeansRadius {
+			break
+		}
 	}
 
-	totalStrength := 0.0
-	for _, member := range cluster.Members {
-		totalStrength += member.Strength
+	return assignments, centroids
+}
+
+// runKMeans performs Lloyd iterations from a deterministic farthest-point
+// initialization
+func runKMeans(vectors [][]float64, k int) ([]int, [][]float64) {
+	centroids := initialCentroids(vectors, k)
+	assignments := make([]int, len(vectors))
+
+	for iter := 0; iter < kMeansMaxIterations; iter++ {
+		// Assign each vector to its nearest centroid
+		changed := false
+		for i, vector := range vectors {
+			nearest := nearestCentroid(vector, centroids)
+			if assignments[i] != nearest {
+				assignments[i] = nearest
+				changed = true
+			}
+		}
+		if iter > 0 && !changed {
+			break
+		}
+
+		// Recompute centroids from assignments
+		for c := range centroids {
+			sum := make([]float64, len(vectors[0]))
+			count := 0
+			for i, vector := range vectors {
+				if assignments[i] != c {
+					continue
+				}
+				for d, v := range vector {
+					sum[d] += v
+				}
+				count++
+			}
+			if count == 0 {
+				// Keep an emptied centroid where it was
+				continue
+			}
+			for d := range sum {
+				sum[d] /= float64(count)
+			}
+			centroids[c] = sum
+		}
 	}
 
-	return totalStrength / float64(len(cluster.Members))
+	return assignments, centroids
 }
 
-func calculateClusterCoherence(cluster *InterestCluster) float64 {
-	if len(cluster.Members) < 2 {
-		return 1.0
+// initialCentroids seeds k-means with the first vector and then the
+// vector farthest from all chosen centroids (farthest-point traversal)
+func initialCentroids(vectors [][]float64, k int) [][]float64 {
+	centroids := make([][]float64, 0, k)
+	centroids = append(centroids, append([]float64(nil), vectors[0]...))
+
+	for len(centroids) < k {
+		farthest := 0
+		farthestDist := -1.0
+		for i, vector := range vectors {
+			dist := math.Inf(1)
+			for _, centroid := range centroids {
+				if d := euclideanDistance(vector, centroid); d < dist {
+					dist = d
+				}
+			}
+			if dist > farthestDist {
+				farthestDist = dist
+				farthest = i
+			}
+		}
+		centroids = append(centroids, append([]float64(nil), vectors[farthest]...))
 	}
 
-	// Calculate average pairwise similarity
-	totalSimilarity := 0.0
-	pairs := 0
+	return centroids
+}
+
+func nearestCentroid(vector []float64, centroids [][]float64) int {
+	nearest := 0
+	nearestDist := math.Inf(1)
+	for c, centroid := range centroids {
+		if d := euclideanDistance(vector, centroid); d < nearestDist {
+			nearestDist = d
+			nearest = c
+		}
+	}
+	return nearest
+}
 
-	for i := 0; i < len(cluster.Members); i++ {
-		for j := i + 1; j < len(cluster.Members); j++ {
-			totalSimilarity += calculateInterestSimilarity(cluster.Members[i], cluster.Members[j])
-			pairs++
+func maxCentroidDistance(vectors [][]float64, assignments []int, centroids [][]float64) float64 {
+	max := 0.0
+	for i, vector := range vectors {
+		if d := euclideanDistance(vector, centroids[assignments[i]]); d > max {
+			max = d
 		}
 	}
+	return max
+}
 
-	if pairs == 0 {
+// centroidCoherence maps the average member-to-centroid distance into
+// (0, 1], with tighter clusters scoring higher
+func centroidCoherence(vectors [][]float64, centroid []float64) float64 {
+	if len(vectors) == 0 {
 		return 1.0
 	}
 
-	return totalSimilarity / float64(pairs)
+	total := 0.0
+	for _, vector := range vectors {
+		total += euclideanDistance(vector, centroid)
+	}
+	return 1.0 / (1.0 + total/float64(len(vectors)))
+}
+
+func euclideanDistance(a, b []float64) float64 {
+	sum := 0.0
+	for d := range a {
+		diff := a[d] - b[d]
+		sum += diff * diff
+	}
+	return math.Sqrt(sum)
+}
+
+// mergeUnique combines two string slices into a fresh deduplicated
+// slice, preserving first-seen order and never aliasing either input
+func mergeUnique(a, b []string) []string {
+	merged := make([]string, 0, len(a)+len(b))
+	seen := make(map[string]bool, len(a)+len(b))
+
+	for _, s := range a {
+		if !seen[s] {
+			seen[s] = true
+			merged = append(merged, s)
+		}
+	}
+	for _, s := range b {
+		if !seen[s] {
+			seen[s] = true
+			merged = append(merged, s)
+		}
+	}
+
+	return merged
 }
 
 func synthesizeInterestName(name1, name2 string) string {
@@ -340,20 +654,20 @@ func synthesizeInterestName(name1, name2 string) string {
 	return name1 + " & " + name2
 }
 
-func shouldMutate(rate float64) bool {
-	return math.Floor(math.Mod(float64(time.Now().UnixNano()), 100)) < (rate * 100)
+func (iee *InterestEvolutionEngine) shouldMutate() bool {
+	return iee.rng.Chance(iee.mutationRate)
 }
 
-func applyMutation(interest *Interest) {
+func (iee *InterestEvolutionEngine) applyMutation(interest *Interest) {
 	// Random mutation to parameters
 	mutationStrength := 0.1
 
 	// Mutate arousal
-	interest.Arousal += (math.Mod(float64(time.Now().UnixNano()), 2.0) - 1.0) * mutationStrength
+	interest.Arousal += (iee.rng.Float64()*2.0 - 1.0) * mutationStrength
 	interest.Arousal = math.Max(0.0, math.Min(1.0, interest.Arousal))
 
 	// Mutate growth
-	interest.Growth += (math.Mod(float64(time.Now().UnixNano()), 2.0) - 1.0) * mutationStrength
+	interest.Growth += (iee.rng.Float64()*2.0 - 1.0) * mutationStrength
 	interest.Growth = math.Max(0.0, math.Min(1.0, interest.Growth))
 }
 
@@ -369,9 +683,5 @@ func pruneWeakInterests(interests []*Interest) {
 }
 
 func generateClusterID() string {
-	return fmt.Sprintf("cluster_%d", time.Now().UnixNano())
-}
-
-var generateInterestID = func(name string) string {
-	return fmt.Sprintf("interest_%s_%d", name, time.Now().UnixNano())
+	return randutil.NextID("cluster")
 }
@@ -0,0 +1,289 @@
+package echobeats
+
+import (
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/EchoCog/echollama/core/persistence"
+)
+
+func TestMutationMagnitudesSpreadAcrossRange(t *testing.T) {
+	engine := NewInterestEvolutionEngineWithSeed(42)
+
+	// Collect arousal deltas from many mutations of a midpoint interest
+	var sawPositive, sawNegative bool
+	var maxDelta, minDelta float64
+	for i := 0; i < 200; i++ {
+		interest := &Interest{Arousal: 0.5, Growth: 0.5}
+		engine.applyMutation(interest)
+
+		delta := interest.Arousal - 0.5
+		if delta > 0 {
+			sawPositive = true
+		}
+		if delta < 0 {
+			sawNegative = true
+		}
+		if delta > maxDelta {
+			maxDelta = delta
+		}
+		if delta < minDelta {
+			minDelta = delta
+		}
+		if delta > 0.1 || delta < -0.1 {
+			t.Fatalf("mutation delta %f outside ±0.1", delta)
+		}
+	}
+
+	if !sawPositive || !sawNegative {
+		t.Error("mutation deltas should cover both signs")
+	}
+	if maxDelta < 0.05 || minDelta > -0.05 {
+		t.Errorf("mutation deltas should spread across the range, got [%f, %f]", minDelta, maxDelta)
+	}
+}
+
+func TestMutationReproducibleUnderFixedSeed(t *testing.T) {
+	run := func(seed int64) []float64 {
+		engine := NewInterestEvolutionEngineWithSeed(seed)
+		values := make([]float64, 0, 20)
+		for i := 0; i < 20; i++ {
+			interest := &Interest{Arousal: 0.5, Growth: 0.5}
+			engine.applyMutation(interest)
+			values = append(values, interest.Arousal, interest.Growth)
+		}
+		return values
+	}
+
+	first := run(7)
+	second := run(7)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("equal seeds diverged at %d: %f vs %f", i, first[i], second[i])
+		}
+	}
+
+	other := run(8)
+	same := true
+	for i := range first {
+		if first[i] != other[i] {
+			same = false
+			break
+		}
+	}
+	if same {
+		t.Error("different seeds should produce different mutation streams")
+	}
+}
+
+// syntheticInterest builds an interest at the given feature point
+func syntheticInterest(id string, strength, valence float64, topics ...string) *Interest {
+	return &Interest{
+		ID:            id,
+		Name:          id,
+		Strength:      strength,
+		Salience:      strength,
+		Valence:       valence,
+		Arousal:       0.5,
+		Competence:    0.5,
+		RelatedTopics: topics,
+	}
+}
+
+func TestDiscoverClustersSeparatesDistinctGroups(t *testing.T) {
+	engine := NewInterestEvolutionEngineWithSeed(3)
+
+	interests := []*Interest{
+		syntheticInterest("neural nets", 0.90, 0.8, "ai", "ml"),
+		syntheticInterest("transformers", 0.85, 0.8, "ai", "ml"),
+		syntheticInterest("reinforcement", 0.88, 0.7, "ai", "ml"),
+		syntheticInterest("guitar", 0.20, -0.4, "music"),
+		syntheticInterest("piano", 0.25, -0.5, "music"),
+		syntheticInterest("drums", 0.22, -0.4, "music"),
+	}
+
+	clusters := engine.DiscoverClusters(interests)
+	if len(clusters) != 2 {
+		t.Fatalf("two separable groups should give two clusters, got %d", len(clusters))
+	}
+
+	for _, cluster := range clusters {
+		if len(cluster.Members) != 3 {
+			t.Errorf("cluster %s should have 3 members, got %d", cluster.CoreTopic, len(cluster.Members))
+		}
+		if len(cluster.Centroid) == 0 {
+			t.Errorf("cluster %s should carry its centroid", cluster.CoreTopic)
+		}
+		if cluster.Coherence <= 0.5 {
+			t.Errorf("tight cluster %s should be coherent, got %f", cluster.CoreTopic, cluster.Coherence)
+		}
+	}
+
+	// The strongest member anchors each cluster's core topic
+	for _, cluster := range clusters {
+		if cluster.CoreTopic != "neural nets" && cluster.CoreTopic != "piano" {
+			t.Errorf("core topic should be the strongest member, got %s", cluster.CoreTopic)
+		}
+	}
+}
+
+func TestDiscoverClustersSingleGroupStaysTogether(t *testing.T) {
+	engine := NewInterestEvolutionEngineWithSeed(3)
+
+	interests := []*Interest{
+		syntheticInterest("a", 0.50, 0.5, "topic"),
+		syntheticInterest("b", 0.52, 0.5, "topic"),
+		syntheticInterest("c", 0.48, 0.5, "topic"),
+	}
+
+	clusters := engine.DiscoverClusters(interests)
+	if len(clusters) != 1 {
+		t.Fatalf("one tight group should give one cluster, got %d", len(clusters))
+	}
+	if len(clusters[0].Members) != 3 {
+		t.Errorf("cluster should keep all members, got %d", len(clusters[0].Members))
+	}
+
+	expected := (0.50 + 0.52 + 0.48) / 3.0
+	if math.Abs(clusters[0].Strength-expected) > 1e-9 {
+		t.Errorf("strength should average members, got %f", clusters[0].Strength)
+	}
+}
+
+func TestEmergentInterestMergesTopicsWithoutDuplicates(t *testing.T) {
+	engine := NewInterestEvolutionEngineWithSeed(5)
+	engine.mutationRate = 0.0
+
+	parent1 := syntheticInterest("ai", 0.8, 0.5, "ml", "math")
+	parent1.Tags = []string{"core", "technical"}
+	parent2 := syntheticInterest("music", 0.6, 0.5, "math", "rhythm")
+	parent2.Tags = []string{"creative", "core"}
+
+	child := engine.GenerateEmergentInterest(parent1, parent2)
+
+	wantTopics := []string{"ml", "math", "rhythm"}
+	if len(child.RelatedTopics) != len(wantTopics) {
+		t.Fatalf("topics should dedupe to %v, got %v", wantTopics, child.RelatedTopics)
+	}
+	for i, topic := range wantTopics {
+		if child.RelatedTopics[i] != topic {
+			t.Errorf("topic %d should be %s, got %s", i, topic, child.RelatedTopics[i])
+		}
+	}
+
+	if len(child.Tags) != 3 {
+		t.Errorf("tags should dedupe to 3, got %v", child.Tags)
+	}
+
+	// Mutating the child must not touch parent1's backing arrays
+	child.RelatedTopics[0] = "overwritten"
+	child.Tags[0] = "overwritten"
+	if parent1.RelatedTopics[0] != "ml" || parent1.Tags[0] != "core" {
+		t.Error("child slices should not alias the parent's")
+	}
+}
+
+func TestEvolutionStateRoundTripsThroughStore(t *testing.T) {
+	store, err := persistence.NewSQLiteStore(filepath.Join(t.TempDir(), "evolution.db"))
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	first := NewInterestEvolutionEngineWithSeed(11)
+	interest := syntheticInterest("hypergraphs", 0.5, 0.5, "memory")
+	outcome := &EngagementOutcome{
+		InterestID:   interest.ID,
+		Timestamp:    time.Now(),
+		Reward:       0.8,
+		LearningGain: 0.6,
+		Satisfaction: 0.7,
+		NoveltyValue: 0.5,
+	}
+	first.ApplyReinforcement(interest, outcome)
+	reinforced := interest.Strength
+	if reinforced <= 0.5 {
+		t.Fatalf("reinforcement should raise strength, got %f", reinforced)
+	}
+
+	if err := first.Save(store); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	second := NewInterestEvolutionEngineWithSeed(11)
+	if err := second.Load(store); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+	restored, ok := second.engagementOutcomes[interest.ID]
+	if !ok {
+		t.Fatal("engagement outcome should survive the round trip")
+	}
+	if restored.Reward != 0.8 || restored.LearningGain != 0.6 {
+		t.Errorf("outcome fields should round-trip, got %+v", restored)
+	}
+	if second.totalEvolutions != 1 {
+		t.Errorf("evolution counter should round-trip, got %d", second.totalEvolutions)
+	}
+}
+
+func TestHigherLearningWeightBoostsLearningHeavyOutcomes(t *testing.T) {
+	outcome := func() *EngagementOutcome {
+		return &EngagementOutcome{
+			InterestID:   "study",
+			LearningGain: 0.9,
+		}
+	}
+
+	baseline := NewInterestEvolutionEngineWithSeed(1)
+	baselineInterest := syntheticInterest("study", 0.5, 0.5)
+	baseline.ApplyReinforcement(baselineInterest, outcome())
+
+	weighted := NewInterestEvolutionEngineWithSeed(1)
+	config := DefaultReinforcementConfig()
+	config.LearningGainWeight = 0.9
+	if err := weighted.SetReinforcementConfig(config); err != nil {
+		t.Fatalf("SetReinforcementConfig failed: %v", err)
+	}
+	weightedInterest := syntheticInterest("study", 0.5, 0.5)
+	weighted.ApplyReinforcement(weightedInterest, outcome())
+
+	if weightedInterest.Strength <= baselineInterest.Strength {
+		t.Errorf("higher learning weight should grow strength faster: %f vs %f",
+			weightedInterest.Strength, baselineInterest.Strength)
+	}
+}
+
+func TestReinforcementConfigRejectsNegativeWeights(t *testing.T) {
+	engine := NewInterestEvolutionEngineWithSeed(1)
+
+	config := DefaultReinforcementConfig()
+	config.NoveltyWeight = -0.1
+	if err := engine.SetReinforcementConfig(config); err == nil {
+		t.Error("negative weight should fail validation")
+	}
+
+	// The default weights must still be in place
+	engine.mu.RLock()
+	defer engine.mu.RUnlock()
+	if engine.reinforcement != DefaultReinforcementConfig() {
+		t.Errorf("rejected config should not be applied, got %+v", engine.reinforcement)
+	}
+}
+
+func TestMutationDecisionHonorsRate(t *testing.T) {
+	engine := NewInterestEvolutionEngineWithSeed(1)
+	engine.mutationRate = 0.0
+	if engine.shouldMutate() {
+		t.Error("zero rate should never mutate")
+	}
+
+	engine.mutationRate = 1.0
+	if !engine.shouldMutate() {
+		t.Error("unit rate should always mutate")
+	}
+}
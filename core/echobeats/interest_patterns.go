@@ -7,6 +7,8 @@ import (
 	"os"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/randutil"
 )
 
 // InterestPatternSystem tracks and develops autonomous interests
@@ -353,7 +355,7 @@ func (ips *InterestPatternSystem) ShouldEngage(topic string) (bool, float64) {
 	}
 	
 	// For unknown topics, use exploration rate
-	shouldEngage := ips.curiosityLevel > 0.5 && (float64(time.Now().UnixNano()%100)/100.0) < ips.explorationRate
+	shouldEngage := ips.curiosityLevel > 0.5 && randutil.Chance(ips.explorationRate)
 	return shouldEngage, ips.curiosityLevel * ips.explorationRate
 }
 
@@ -528,7 +530,7 @@ func (ips *InterestPatternSystem) PersistState() {
 // Helper functions
 
 func generateInterestID(name string) string {
-	return fmt.Sprintf("interest_%s_%d", sanitizeName(name), time.Now().UnixNano())
+	return randutil.NextID("interest_" + sanitizeName(name))
 }
 
 func sanitizeName(name string) string {
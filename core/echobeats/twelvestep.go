@@ -452,10 +452,19 @@ func (tseb *TwelveStepEchoBeats) getMode(step int) CognitiveMode {
 func (tseb *TwelveStepEchoBeats) GetMetrics() *TwelveStepMetrics {
 	tseb.metrics.mu.RLock()
 	defer tseb.metrics.mu.RUnlock()
-	
-	// Return a copy
-	metricsCopy := *tseb.metrics
-	return &metricsCopy
+
+	// Return a field-by-field copy so the caller gets a detached
+	// snapshot without copying the mutex
+	return &TwelveStepMetrics{
+		TotalCycles:            tseb.metrics.TotalCycles,
+		StepExecutionTimes:     tseb.metrics.StepExecutionTimes,
+		EngineActivations:      tseb.metrics.EngineActivations,
+		RelevanceRealizations:  tseb.metrics.RelevanceRealizations,
+		AffordanceInteractions: tseb.metrics.AffordanceInteractions,
+		SalienceSimulations:    tseb.metrics.SalienceSimulations,
+		PhaseTransitions:       tseb.metrics.PhaseTransitions,
+		ModeTransitions:        tseb.metrics.ModeTransitions,
+	}
 }
 
 // GetStatus returns current status
@@ -3,8 +3,11 @@ package echodream
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"time"
+
+	"github.com/EchoCog/echollama/core/logutil"
 )
 
 // AutonomousWakeRestController manages self-directed wake and rest cycles
@@ -16,7 +19,9 @@ type AutonomousWakeRestController struct {
 	
 	// Core components
 	dreamSystem           *EchoDream
-	
+	loadSource            CognitiveLoadSource
+	config                ControllerConfig
+
 	// Cognitive state monitoring
 	cognitiveLoad         float64
 	fatigueLevel          float64
@@ -34,6 +39,11 @@ type AutonomousWakeRestController struct {
 	consolidationThreshold float64
 	minWakeDuration       time.Duration
 	minRestDuration       time.Duration
+
+	// Circadian modulation (disabled until SetCircadianModel is called)
+	circadianPeriod       time.Duration
+	circadianAmplitude    float64
+	circadianEpoch        time.Time
 	
 	// Metrics
 	wakeEpisodes          uint64
@@ -44,6 +54,67 @@ type AutonomousWakeRestController struct {
 	totalRestTime         time.Duration
 	
 	running               bool
+
+	// Verbose enables the transition prints
+	Verbose               bool
+
+	// Observers
+	stateCallback         func(from, to WakeRestState, metrics map[string]interface{})
+}
+
+// CognitiveLoadSource supplies measured cognitive activity signals so
+// the controller can couple to real load instead of simulating it,
+// e.g. the persistence layer's unconsolidated thought count or the N5
+// system's operation rate
+type CognitiveLoadSource interface {
+	// CurrentLoad reports the current cognitive load in [0, 1]
+	CurrentLoad() float64
+
+	// PendingConsolidation reports how many items await consolidation
+	PendingConsolidation() int
+}
+
+// consolidationCapacity is the pending-item count that saturates
+// consolidation need when reading from a load source
+const consolidationCapacity = 50.0
+
+// ControllerConfig tunes the controller's loop intervals, thresholds,
+// and minimum state durations
+type ControllerConfig struct {
+	MonitorInterval        time.Duration // Cognitive state monitoring tick
+	DecisionInterval       time.Duration // Autonomous decision tick
+	AssessmentInterval     time.Duration // Integration assessment tick
+	FatigueThreshold       float64
+	ConsolidationThreshold float64
+	MinWakeDuration        time.Duration
+	MinRestDuration        time.Duration
+}
+
+// DefaultControllerConfig preserves the controller's historical tuning
+func DefaultControllerConfig() ControllerConfig {
+	return ControllerConfig{
+		MonitorInterval:        5 * time.Second,
+		DecisionInterval:       10 * time.Second,
+		AssessmentInterval:     30 * time.Second,
+		FatigueThreshold:       0.7,
+		ConsolidationThreshold: 0.6,
+		MinWakeDuration:        5 * time.Minute,
+		MinRestDuration:        2 * time.Minute,
+	}
+}
+
+// Validate checks that all loop intervals are positive
+func (c ControllerConfig) Validate() error {
+	if c.MonitorInterval <= 0 {
+		return fmt.Errorf("monitor interval must be positive, got %v", c.MonitorInterval)
+	}
+	if c.DecisionInterval <= 0 {
+		return fmt.Errorf("decision interval must be positive, got %v", c.DecisionInterval)
+	}
+	if c.AssessmentInterval <= 0 {
+		return fmt.Errorf("assessment interval must be positive, got %v", c.AssessmentInterval)
+	}
+	return nil
 }
 
 // WakeRestState represents the current wake/rest state
@@ -60,25 +131,65 @@ func (wrs WakeRestState) String() string {
 	return [...]string{"Awake", "Resting", "Dreaming", "Transitioning"}[wrs]
 }
 
-// NewAutonomousWakeRestController creates a new autonomous controller
-func NewAutonomousWakeRestController(dreamSystem *EchoDream) *AutonomousWakeRestController {
+// NewAutonomousWakeRestController creates a new autonomous controller.
+// An optional ControllerConfig overrides the default tuning; invalid
+// intervals fall back to their defaults.
+func NewAutonomousWakeRestController(dreamSystem *EchoDream, config ...ControllerConfig) *AutonomousWakeRestController {
 	ctx, cancel := context.WithCancel(context.Background())
-	
+
+	cfg := DefaultControllerConfig()
+	if len(config) > 0 {
+		cfg = config[0].withDefaults()
+	}
+
 	return &AutonomousWakeRestController{
 		ctx:                    ctx,
 		cancel:                 cancel,
 		dreamSystem:            dreamSystem,
+		config:                 cfg,
 		cognitiveLoad:          0.3,
 		fatigueLevel:           0.0,
 		integrationBacklog:     0,
 		consolidationNeed:      0.0,
 		currentState:           StateAwake,
 		lastStateChange:        time.Now(),
-		fatigueThreshold:       0.7,
-		consolidationThreshold: 0.6,
-		minWakeDuration:        5 * time.Minute,
-		minRestDuration:        2 * time.Minute,
+		fatigueThreshold:       cfg.FatigueThreshold,
+		consolidationThreshold: cfg.ConsolidationThreshold,
+		minWakeDuration:        cfg.MinWakeDuration,
+		minRestDuration:        cfg.MinRestDuration,
+		Verbose:                true,
+	}
+}
+
+// withDefaults replaces non-positive intervals with the defaults
+func (c ControllerConfig) withDefaults() ControllerConfig {
+	defaults := DefaultControllerConfig()
+	if c.MonitorInterval <= 0 {
+		c.MonitorInterval = defaults.MonitorInterval
+	}
+	if c.DecisionInterval <= 0 {
+		c.DecisionInterval = defaults.DecisionInterval
+	}
+	if c.AssessmentInterval <= 0 {
+		c.AssessmentInterval = defaults.AssessmentInterval
 	}
+	return c
+}
+
+// OnStateChange registers a callback fired on every wake/rest
+// transition with the cognitive metrics at that moment
+func (awrc *AutonomousWakeRestController) OnStateChange(callback func(from, to WakeRestState, metrics map[string]interface{})) {
+	awrc.mu.Lock()
+	defer awrc.mu.Unlock()
+	awrc.stateCallback = callback
+}
+
+// SetLoadSource wires a measured cognitive load source into the
+// controller; without one the simulated model is used
+func (awrc *AutonomousWakeRestController) SetLoadSource(source CognitiveLoadSource) {
+	awrc.mu.Lock()
+	defer awrc.mu.Unlock()
+	awrc.loadSource = source
 }
 
 // Start begins autonomous wake/rest management
@@ -114,7 +225,7 @@ func (awrc *AutonomousWakeRestController) Stop() {
 
 // cognitiveStateMonitoringLoop continuously monitors cognitive state
 func (awrc *AutonomousWakeRestController) cognitiveStateMonitoringLoop() {
-	ticker := time.NewTicker(5 * time.Second)
+	ticker := time.NewTicker(awrc.config.MonitorInterval)
 	defer ticker.Stop()
 	
 	for {
@@ -131,40 +242,70 @@ func (awrc *AutonomousWakeRestController) cognitiveStateMonitoringLoop() {
 func (awrc *AutonomousWakeRestController) updateCognitiveState() {
 	awrc.mu.Lock()
 	defer awrc.mu.Unlock()
-	
+
+	if awrc.loadSource != nil {
+		awrc.updateFromLoadSource()
+	} else {
+		awrc.updateSimulated()
+	}
+
+	// Clamp values
+	awrc.fatigueLevel = clamp(awrc.fatigueLevel, 0.0, 1.0)
+	awrc.cognitiveLoad = clamp(awrc.cognitiveLoad, 0.0, 1.0)
+	awrc.consolidationNeed = clamp(awrc.consolidationNeed, 0.0, 1.0)
+}
+
+// updateFromLoadSource derives cognitive state from measured signals.
+// Caller must hold the lock.
+func (awrc *AutonomousWakeRestController) updateFromLoadSource() {
+	load := clamp(awrc.loadSource.CurrentLoad(), 0.0, 1.0)
+	pending := awrc.loadSource.PendingConsolidation()
+
+	awrc.cognitiveLoad = load
+	awrc.integrationBacklog = pending
+	awrc.consolidationNeed = clamp(float64(pending)/consolidationCapacity, 0.0, 1.0)
+
+	switch awrc.currentState {
+	case StateAwake:
+		// Fatigue accumulates faster under heavier load
+		awrc.fatigueLevel += 0.005 + 0.015*load
+
+	case StateResting, StateDreaming:
+		// Decrease fatigue while resting
+		awrc.fatigueLevel -= 0.02
+	}
+}
+
+// updateSimulated advances the simulated cognitive model used when no
+// load source is wired in. Caller must hold the lock.
+func (awrc *AutonomousWakeRestController) updateSimulated() {
 	// Update based on current state
 	switch awrc.currentState {
 	case StateAwake:
 		// Increase fatigue while awake
 		awrc.fatigueLevel += 0.01
-		
+
 		// Cognitive load varies based on activity
-		// In real implementation, would measure actual cognitive activity
 		awrc.cognitiveLoad = 0.5 + 0.3*awrc.fatigueLevel
-		
+
 		// Increase consolidation need over time
 		awrc.consolidationNeed += 0.005
-		
+
 	case StateResting, StateDreaming:
 		// Decrease fatigue while resting
 		awrc.fatigueLevel -= 0.02
-		
+
 		// Decrease cognitive load
 		awrc.cognitiveLoad -= 0.03
-		
+
 		// Decrease consolidation need as memories are processed
 		awrc.consolidationNeed -= 0.01
 	}
-	
-	// Clamp values
-	awrc.fatigueLevel = clamp(awrc.fatigueLevel, 0.0, 1.0)
-	awrc.cognitiveLoad = clamp(awrc.cognitiveLoad, 0.0, 1.0)
-	awrc.consolidationNeed = clamp(awrc.consolidationNeed, 0.0, 1.0)
 }
 
 // autonomousDecisionLoop makes autonomous wake/rest decisions
 func (awrc *AutonomousWakeRestController) autonomousDecisionLoop() {
-	ticker := time.NewTicker(10 * time.Second)
+	ticker := time.NewTicker(awrc.config.DecisionInterval)
 	defer ticker.Stop()
 	
 	for {
@@ -209,22 +350,24 @@ func (awrc *AutonomousWakeRestController) shouldEnterRest(fatigue, consolidation
 	if timeSinceChange < awrc.minWakeDuration {
 		return false
 	}
-	
+
+	offset := awrc.circadianOffset()
+
 	// Rest if fatigue is high
-	if fatigue > awrc.fatigueThreshold {
+	if fatigue > clamp(awrc.fatigueThreshold+offset, 0.0, 1.0) {
 		return true
 	}
-	
+
 	// Rest if consolidation need is high
-	if consolidation > awrc.consolidationThreshold {
+	if consolidation > clamp(awrc.consolidationThreshold+offset, 0.0, 1.0) {
 		return true
 	}
-	
+
 	// Rest if both are moderately high
-	if fatigue > 0.5 && consolidation > 0.4 {
+	if fatigue > 0.5+offset && consolidation > 0.4+offset {
 		return true
 	}
-	
+
 	return false
 }
 
@@ -234,78 +377,135 @@ func (awrc *AutonomousWakeRestController) shouldWake(fatigue, consolidation floa
 	if timeSinceChange < awrc.minRestDuration {
 		return false
 	}
-	
+
+	offset := awrc.circadianOffset()
+
 	// Wake if fatigue is low
-	if fatigue < 0.2 {
+	if fatigue < 0.2+offset {
 		return true
 	}
-	
+
 	// Wake if consolidation need is low
-	if consolidation < 0.2 {
+	if consolidation < 0.2+offset {
 		return true
 	}
-	
+
 	// Wake if both are sufficiently low
-	if fatigue < 0.4 && consolidation < 0.3 {
+	if fatigue < 0.4+offset && consolidation < 0.3+offset {
 		return true
 	}
-	
+
 	return false
 }
 
+// SetCircadianModel enables sinusoidal modulation of the rest/wake
+// thresholds over the given period, approximating an ultradian rhythm
+// (e.g. 90 minutes). Amplitude is the peak threshold shift and is
+// clamped to [0, 0.5]; at the trough of the cycle thresholds drop by
+// that much, so the controller naturally favors rest at that phase. A
+// non-positive period disables the model.
+func (awrc *AutonomousWakeRestController) SetCircadianModel(period time.Duration, amplitude float64) {
+	awrc.mu.Lock()
+	defer awrc.mu.Unlock()
+
+	awrc.circadianPeriod = period
+	awrc.circadianAmplitude = clamp(amplitude, 0.0, 0.5)
+	awrc.circadianEpoch = time.Now()
+}
+
+// circadianOffset returns the phase-dependent threshold shift in
+// [-amplitude, +amplitude], or 0 when no circadian model is set
+func (awrc *AutonomousWakeRestController) circadianOffset() float64 {
+	awrc.mu.RLock()
+	defer awrc.mu.RUnlock()
+
+	if awrc.circadianPeriod <= 0 {
+		return 0.0
+	}
+
+	phase := time.Since(awrc.circadianEpoch).Seconds() / awrc.circadianPeriod.Seconds()
+	return awrc.circadianAmplitude * math.Sin(2*math.Pi*phase)
+}
+
 // initiateRest begins a rest/dream cycle
 func (awrc *AutonomousWakeRestController) initiateRest() {
 	awrc.mu.Lock()
-	
+
 	// Record wake episode
 	wakeDuration := time.Since(awrc.lastStateChange)
 	awrc.totalWakeTime += wakeDuration
 	awrc.wakeEpisodes++
-	
+
 	// Transition to resting
+	previousState := awrc.currentState
 	awrc.currentState = StateResting
 	awrc.lastStateChange = time.Now()
 	awrc.autonomousRests++
-	
+
+	callback := awrc.stateCallback
+	metrics := awrc.cognitiveMetricsLocked()
+
 	awrc.mu.Unlock()
-	
+
 	// Start dream system
 	if awrc.dreamSystem != nil {
 		awrc.dreamSystem.Start()
 	}
-	
-	fmt.Println("🌙 Autonomous Rest: Entering rest/dream cycle for knowledge integration")
-	fmt.Printf("   Fatigue: %.2f, Consolidation Need: %.2f\n", awrc.fatigueLevel, awrc.consolidationNeed)
+
+	if callback != nil {
+		callback(previousState, StateResting, metrics)
+	}
+
+	if awrc.Verbose {
+		logutil.Logger().Info("entering rest/dream cycle for knowledge integration",
+			logutil.Emoji("🌙"),
+			"state", StateResting.String(),
+			"fatigue", metrics["fatigue_level"],
+			"consolidation_need", metrics["consolidation_need"])
+	}
 }
 
 // initiateWake begins a wake cycle
 func (awrc *AutonomousWakeRestController) initiateWake() {
 	awrc.mu.Lock()
-	
+
 	// Record rest episode
 	restDuration := time.Since(awrc.lastStateChange)
 	awrc.totalRestTime += restDuration
 	awrc.restEpisodes++
-	
+
 	// Transition to awake
+	previousState := awrc.currentState
 	awrc.currentState = StateAwake
 	awrc.lastStateChange = time.Now()
 	awrc.autonomousWakes++
-	
+
+	callback := awrc.stateCallback
+	metrics := awrc.cognitiveMetricsLocked()
+
 	awrc.mu.Unlock()
-	
+
 	// Stop dream system
 	if awrc.dreamSystem != nil {
 		awrc.dreamSystem.Stop()
 	}
-	
-	fmt.Println("☀️  Autonomous Wake: Emerging from rest, ready for new experiences")
-	fmt.Printf("   Fatigue: %.2f, Consolidation Need: %.2f\n", awrc.fatigueLevel, awrc.consolidationNeed)
+
+	if callback != nil {
+		callback(previousState, StateAwake, metrics)
+	}
+
+	if awrc.Verbose {
+		logutil.Logger().Info("emerging from rest, ready for new experiences",
+			logutil.Emoji("☀️"),
+			"state", StateAwake.String(),
+			"fatigue", metrics["fatigue_level"],
+			"consolidation_need", metrics["consolidation_need"])
+	}
 }
 
 // integrationAssessmentLoop assesses knowledge integration needs
 func (awrc *AutonomousWakeRestController) integrationAssessmentLoop() {
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := time.NewTicker(awrc.config.AssessmentInterval)
 	defer ticker.Stop()
 	
 	for {
@@ -322,13 +522,14 @@ func (awrc *AutonomousWakeRestController) integrationAssessmentLoop() {
 func (awrc *AutonomousWakeRestController) assessIntegrationNeeds() {
 	awrc.mu.Lock()
 	defer awrc.mu.Unlock()
-	
-	// In real implementation, would query memory system for:
-	// - Unconsolidated short-term memories
-	// - Unintegrated experiences
-	// - Pending pattern syntheses
-	
-	// For now, simulate assessment
+
+	// A load source reports the real backlog directly
+	if awrc.loadSource != nil {
+		awrc.integrationBacklog = awrc.loadSource.PendingConsolidation()
+		return
+	}
+
+	// Without one, simulate assessment
 	awrc.integrationBacklog = int(awrc.consolidationNeed * 100)
 }
 
@@ -343,7 +544,12 @@ func (awrc *AutonomousWakeRestController) GetState() WakeRestState {
 func (awrc *AutonomousWakeRestController) GetCognitiveMetrics() map[string]interface{} {
 	awrc.mu.RLock()
 	defer awrc.mu.RUnlock()
-	
+
+	return awrc.cognitiveMetricsLocked()
+}
+
+// cognitiveMetricsLocked builds the metrics map; caller holds the lock
+func (awrc *AutonomousWakeRestController) cognitiveMetricsLocked() map[string]interface{} {
 	return map[string]interface{}{
 		"state":               awrc.currentState.String(),
 		"cognitive_load":      awrc.cognitiveLoad,
@@ -0,0 +1,247 @@
+package echodream
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeLoadSource feeds scripted cognitive signals to the controller
+type fakeLoadSource struct {
+	load    float64
+	pending int
+}
+
+func (s *fakeLoadSource) CurrentLoad() float64      { return s.load }
+func (s *fakeLoadSource) PendingConsolidation() int { return s.pending }
+
+func TestLoadSourceDrivesControllerIntoRest(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil)
+	controller.minWakeDuration = 0
+
+	source := &fakeLoadSource{load: 1.0, pending: 100}
+	controller.SetLoadSource(source)
+
+	// Sustained heavy load accumulates fatigue past the threshold
+	for i := 0; i < 40; i++ {
+		controller.updateCognitiveState()
+	}
+
+	metrics := controller.GetCognitiveMetrics()
+	if load := metrics["cognitive_load"].(float64); load != 1.0 {
+		t.Errorf("cognitive load should track the source, got %f", load)
+	}
+	if need := metrics["consolidation_need"].(float64); need != 1.0 {
+		t.Errorf("backlog of 100 should saturate consolidation need, got %f", need)
+	}
+
+	controller.makeAutonomousDecision()
+	if state := controller.GetState(); state != StateResting {
+		t.Errorf("controller should rest under measured load, got %s", state)
+	}
+}
+
+func TestLoadSourceReportsBacklog(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil)
+	controller.SetLoadSource(&fakeLoadSource{load: 0.2, pending: 7})
+
+	controller.assessIntegrationNeeds()
+
+	metrics := controller.GetCognitiveMetrics()
+	if backlog := metrics["integration_backlog"].(int); backlog != 7 {
+		t.Errorf("backlog should come from the source, got %d", backlog)
+	}
+}
+
+func TestLoadSourceRecoveryWakesController(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil)
+	controller.minRestDuration = 0
+	controller.currentState = StateResting
+	controller.fatigueLevel = 0.8
+
+	controller.SetLoadSource(&fakeLoadSource{load: 0.0, pending: 0})
+
+	// Rest under an idle source drains fatigue
+	for i := 0; i < 40; i++ {
+		controller.updateCognitiveState()
+	}
+
+	controller.makeAutonomousDecision()
+	if state := controller.GetState(); state != StateAwake {
+		t.Errorf("controller should wake once recovered, got %s", state)
+	}
+}
+
+func TestStateChangeCallbackFiresOnRest(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil)
+	controller.Verbose = false
+	controller.minWakeDuration = 0
+	controller.SetLoadSource(&fakeLoadSource{load: 1.0, pending: 100})
+
+	type transition struct {
+		from, to WakeRestState
+		metrics  map[string]interface{}
+	}
+	var transitions []transition
+	controller.OnStateChange(func(from, to WakeRestState, metrics map[string]interface{}) {
+		transitions = append(transitions, transition{from, to, metrics})
+	})
+
+	for i := 0; i < 40; i++ {
+		controller.updateCognitiveState()
+	}
+	controller.makeAutonomousDecision()
+
+	if len(transitions) != 1 {
+		t.Fatalf("expected one transition, got %d", len(transitions))
+	}
+	if transitions[0].from != StateAwake || transitions[0].to != StateResting {
+		t.Errorf("transition wrong: %s → %s", transitions[0].from, transitions[0].to)
+	}
+	if need := transitions[0].metrics["consolidation_need"].(float64); need != 1.0 {
+		t.Errorf("callback metrics should capture the transition moment, got %f", need)
+	}
+}
+
+func TestCustomIntervalsDriveLoops(t *testing.T) {
+	config := DefaultControllerConfig()
+	config.MonitorInterval = 10 * time.Millisecond
+	config.DecisionInterval = 10 * time.Millisecond
+	config.AssessmentInterval = 10 * time.Millisecond
+
+	controller := NewAutonomousWakeRestController(nil, config)
+	controller.Verbose = false
+	controller.SetLoadSource(&fakeLoadSource{load: 0.5, pending: 9})
+
+	if err := controller.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer controller.Stop()
+
+	// At 10ms ticks, fatigue and backlog should be updated many times
+	// within the deadline; at the default 5s they would never be
+	deadline := time.After(2 * time.Second)
+	for {
+		metrics := controller.GetCognitiveMetrics()
+		fatigue := metrics["fatigue_level"].(float64)
+		backlog := metrics["integration_backlog"].(int)
+		if fatigue >= 0.05 && backlog == 9 {
+			return
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("loops did not honor custom intervals: fatigue=%f backlog=%d", fatigue, backlog)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestControllerConfigValidateRejectsBadIntervals(t *testing.T) {
+	config := DefaultControllerConfig()
+	if err := config.Validate(); err != nil {
+		t.Errorf("defaults should validate: %v", err)
+	}
+
+	config.DecisionInterval = 0
+	if err := config.Validate(); err == nil {
+		t.Error("zero decision interval should fail validation")
+	}
+
+	config = DefaultControllerConfig()
+	config.MonitorInterval = -time.Second
+	if err := config.Validate(); err == nil {
+		t.Error("negative monitor interval should fail validation")
+	}
+}
+
+func TestControllerConfigDefaultsFillMissingIntervals(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil, ControllerConfig{
+		MonitorInterval:  time.Millisecond,
+		FatigueThreshold: 0.9,
+	})
+
+	if controller.config.MonitorInterval != time.Millisecond {
+		t.Errorf("explicit interval should be kept, got %v", controller.config.MonitorInterval)
+	}
+	if controller.config.DecisionInterval != 10*time.Second {
+		t.Errorf("missing decision interval should default, got %v", controller.config.DecisionInterval)
+	}
+	if controller.config.AssessmentInterval != 30*time.Second {
+		t.Errorf("missing assessment interval should default, got %v", controller.config.AssessmentInterval)
+	}
+	if controller.fatigueThreshold != 0.9 {
+		t.Errorf("threshold should come from config, got %f", controller.fatigueThreshold)
+	}
+}
+
+// atCircadianPhase rewinds the model epoch so the controller sits at
+// the given fraction of its cycle
+func atCircadianPhase(controller *AutonomousWakeRestController, phase float64) {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	offset := time.Duration(phase * float64(controller.circadianPeriod))
+	controller.circadianEpoch = time.Now().Add(-offset)
+}
+
+func TestCircadianOffsetShiftsAcrossCycle(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil)
+	if offset := controller.circadianOffset(); offset != 0.0 {
+		t.Errorf("offset should be zero without a model, got %f", offset)
+	}
+
+	controller.SetCircadianModel(90*time.Minute, 0.2)
+
+	atCircadianPhase(controller, 0.25)
+	if offset := controller.circadianOffset(); offset < 0.19 {
+		t.Errorf("quarter phase should peak near +0.2, got %f", offset)
+	}
+
+	atCircadianPhase(controller, 0.75)
+	if offset := controller.circadianOffset(); offset > -0.19 {
+		t.Errorf("three-quarter phase should trough near -0.2, got %f", offset)
+	}
+}
+
+func TestCircadianTroughFavorsRest(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil)
+	controller.minWakeDuration = 0
+
+	// Below the static 0.7 fatigue threshold: no rest without a rhythm
+	if controller.shouldEnterRest(0.65, 0.0, time.Hour) {
+		t.Error("moderate fatigue should not trigger rest without circadian model")
+	}
+
+	controller.SetCircadianModel(90*time.Minute, 0.2)
+	atCircadianPhase(controller, 0.75)
+
+	// At the trough the effective threshold drops to ~0.5
+	if !controller.shouldEnterRest(0.65, 0.0, time.Hour) {
+		t.Error("trough phase should lower the fatigue threshold into rest")
+	}
+
+	// The same trough makes waking harder
+	controller.minRestDuration = 0
+	if controller.shouldWake(0.25, 0.25, time.Hour) {
+		t.Error("trough phase should hold the controller in rest")
+	}
+}
+
+func TestCircadianAmplitudeClamped(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil)
+	controller.SetCircadianModel(90*time.Minute, 2.0)
+
+	atCircadianPhase(controller, 0.25)
+	if offset := controller.circadianOffset(); offset > 0.5 {
+		t.Errorf("amplitude should clamp to 0.5, got offset %f", offset)
+	}
+}
+
+func TestSimulatedModelUsedWithoutSource(t *testing.T) {
+	controller := NewAutonomousWakeRestController(nil)
+
+	controller.updateCognitiveState()
+
+	metrics := controller.GetCognitiveMetrics()
+	if fatigue := metrics["fatigue_level"].(float64); fatigue != 0.01 {
+		t.Errorf("simulated fatigue increment wrong: %f", fatigue)
+	}
+}
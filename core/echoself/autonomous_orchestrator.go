@@ -315,7 +315,7 @@ func (ae *AutonomousEchoself) Start() error {
 	go ae.memoryConsolidationLoop()
 	
 	fmt.Println("🌳 All systems active. Stream of consciousness initiated.")
-	fmt.Println("🌳 ═══════════════════════════════════════════════════════\n")
+	fmt.Println("🌳 ═══════════════════════════════════════════════════════")
 	
 	return nil
 }
@@ -345,7 +345,7 @@ func (ae *AutonomousEchoself) Stop() error {
 	// Print final metrics
 	ae.printMetrics()
 	
-	fmt.Println("🌳 ═══════════════════════════════════════════════════════\n")
+	fmt.Println("🌳 ═══════════════════════════════════════════════════════")
 	
 	return nil
 }
@@ -631,7 +631,7 @@ func (ae *AutonomousEchoself) consolidateMemories() {
 	
 	// Convert thoughts to episodic memories
 	for _, thought := range thoughts {
-		memory := echodream.EpisodicMemory{
+		memory := echodream.DreamEpisodicMemory{
 			ID:        thought.ID,
 			Timestamp: thought.Timestamp,
 			Content:   thought.Content,
@@ -0,0 +1,92 @@
+package echoself
+
+import (
+	"fmt"
+
+	"github.com/EchoCog/echollama/core/persistence"
+)
+
+// coherenceStateKey is the state key the coherence tracker persists under
+const coherenceStateKey = "coherence_tracker_state"
+
+// persistedSnapshots bounds how many coherence snapshots survive a restart
+const persistedSnapshots = 200
+
+// coherenceTrackerState is the envelope saved through the store's
+// key-value state API
+type coherenceTrackerState struct {
+	IdentitySignature string              `json:"identity_signature"`
+	CoreValues        []string            `json:"core_values"`
+	EmergentTraits    map[string]float64  `json:"emergent_traits"`
+	CoherenceScore    float64             `json:"coherence_score"`
+	ContinuityScore   float64             `json:"continuity_score"`
+	ConsistencyScore  float64             `json:"consistency_score"`
+	AuthenticityScore float64             `json:"authenticity_score"`
+	CoherenceHistory  []CoherenceSnapshot `json:"coherence_history"`
+}
+
+// Save persists the identity signature, core values, emergent traits,
+// and a bounded window of coherence snapshots so identity continuity
+// survives a restart
+func (ct *CoherenceTracker) Save(store persistence.Store) error {
+	ct.mu.RLock()
+
+	state := coherenceTrackerState{
+		IdentitySignature: ct.identitySignature,
+		CoreValues:        append([]string(nil), ct.coreValues...),
+		EmergentTraits:    make(map[string]float64, len(ct.emergentTraits)),
+		CoherenceScore:    ct.coherenceScore,
+		ContinuityScore:   ct.continuityScore,
+		ConsistencyScore:  ct.consistencyScore,
+		AuthenticityScore: ct.authenticityScore,
+	}
+	for trait, value := range ct.emergentTraits {
+		state.EmergentTraits[trait] = value
+	}
+
+	history := ct.coherenceHistory
+	if len(history) > persistedSnapshots {
+		history = history[len(history)-persistedSnapshots:]
+	}
+	state.CoherenceHistory = append([]CoherenceSnapshot(nil), history...)
+
+	ct.mu.RUnlock()
+
+	if err := store.SaveState(coherenceStateKey, state); err != nil {
+		return fmt.Errorf("failed to save coherence state: %w", err)
+	}
+
+	return nil
+}
+
+// Load restores the identity persisted by Save. The coherence scores and
+// snapshot history are rehydrated so calculateContinuity picks up where
+// the previous run left off.
+func (ct *CoherenceTracker) Load(store persistence.Store) error {
+	var state coherenceTrackerState
+	if err := store.GetState(coherenceStateKey, &state); err != nil {
+		return fmt.Errorf("failed to load coherence state: %w", err)
+	}
+
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	if state.IdentitySignature != "" {
+		ct.identitySignature = state.IdentitySignature
+	}
+	if len(state.CoreValues) > 0 {
+		ct.coreValues = state.CoreValues
+	}
+	if state.EmergentTraits != nil {
+		ct.emergentTraits = state.EmergentTraits
+	}
+	ct.coherenceScore = state.CoherenceScore
+	ct.continuityScore = state.ContinuityScore
+	ct.consistencyScore = state.ConsistencyScore
+	ct.authenticityScore = state.AuthenticityScore
+	if len(state.CoherenceHistory) > 0 {
+		ct.coherenceHistory = state.CoherenceHistory
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package echoself
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/EchoCog/echollama/core/persistence"
+)
+
+func TestCoherenceStateRoundTripsThroughStore(t *testing.T) {
+	store, err := persistence.NewSQLiteStore(filepath.Join(t.TempDir(), "coherence.db"))
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	first := NewCoherenceTracker([]string{"curiosity", "integrity"})
+	first.RecordEvolution(EvolutionEvent{
+		Type:      "growth",
+		NewTraits: map[string]float64{"playfulness": 0.8, "patience": 0.6},
+	})
+	for i := 0; i < 5; i++ {
+		first.Update()
+	}
+
+	if err := first.Save(store); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	// A tracker created with different values should take on the saved
+	// identity wholesale
+	second := NewCoherenceTracker([]string{"something", "else"})
+	if err := second.Load(store); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if second.GetIdentitySignature() != first.GetIdentitySignature() {
+		t.Error("identity signature should survive the restart")
+	}
+	if second.GetCoherenceScore() != first.GetCoherenceScore() {
+		t.Errorf("coherence score not restored: %f vs %f",
+			second.GetCoherenceScore(), first.GetCoherenceScore())
+	}
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+	if second.emergentTraits["playfulness"] != 0.8 || second.emergentTraits["patience"] != 0.6 {
+		t.Errorf("emergent traits not restored: %v", second.emergentTraits)
+	}
+	if len(second.coreValues) != 2 || second.coreValues[0] != "curiosity" {
+		t.Errorf("core values not restored: %v", second.coreValues)
+	}
+	if len(second.coherenceHistory) != len(first.coherenceHistory) {
+		t.Errorf("snapshot window not restored: %d vs %d",
+			len(second.coherenceHistory), len(first.coherenceHistory))
+	}
+
+	// Continuity continues from the restored history rather than the
+	// cold-start baseline
+	if got := second.calculateContinuity(); got == 0.7 && len(second.coherenceHistory) >= 2 {
+		t.Error("continuity should be computed from restored snapshots")
+	}
+}
@@ -29,6 +29,12 @@ type CoherenceTracker struct {
 	recentActions        []Action            // Recent actions for consistency check
 	memoryEchos          []MemoryEcho        // Memory hooks with echo signatures
 	patternRecognitions  []PatternRecognition
+
+	// Optional embedding provider for real echo signatures
+	embedder             EchoEmbedder
+
+	// Optional identity-drift callback
+	driftCallback        func(DriftEvent)
 	
 	// Reflection and assessment
 	reflections          []StructuredReflection
@@ -61,7 +67,8 @@ type MemoryEcho struct {
 	StrategicShift   string
 	PatternRecognized string
 	AnomalyDetected  string
-	EchoSignature    string  // 768-d embedding hash
+	EchoSignature    string    // Quantized embedding signature (content hash fallback)
+	Embedding        []float64 // 768-d embedding when a provider is available
 	MembraneContext  string  // Active cognitive layer
 }
 
@@ -155,7 +162,18 @@ func (ct *CoherenceTracker) Update() {
 	
 	// Take snapshot
 	ct.takeSnapshot(now)
-	
+
+	// Surface identity drift before coherence visibly collapses
+	if ct.driftCallback != nil {
+		if drifting, magnitude, cause := ct.detectDrift(); drifting {
+			ct.driftCallback(DriftEvent{
+				Timestamp: now,
+				Magnitude: magnitude,
+				Cause:     cause,
+			})
+		}
+	}
+
 	ct.lastUpdate = now
 }
 
@@ -196,35 +214,45 @@ func (ct *CoherenceTracker) calculateConsistency() float64 {
 		return 0.7 // Start with modest baseline
 	}
 	
-	// Analyze value alignment across recent actions
-	valueFrequency := make(map[string]int)
+	// Only actions that declared values can speak to alignment; actions
+	// with empty Values are neutral rather than dragging consistency down
+	alignedCount := 0
+	declaredCount := 0
+
 	for _, action := range ct.recentActions {
-		for _, value := range action.Values {
-			valueFrequency[value]++
+		if len(action.Values) == 0 {
+			continue
 		}
-	}
-	
-	// Check if recent actions align with core values
-	coreValueCount := 0
-	totalActionCount := len(ct.recentActions)
-	
-	for _, action := range ct.recentActions {
+		declaredCount++
 		for _, value := range action.Values {
-			for _, coreValue := range ct.coreValues {
-				if value == coreValue {
-					coreValueCount++
-					break
-				}
+			if ct.isCoreValue(value) {
+				alignedCount++
+				break
 			}
 		}
 	}
-	
-	// Consistency = proportion of actions aligned with core values
-	consistency := float64(coreValueCount) / float64(totalActionCount)
-	
+
+	if declaredCount == 0 {
+		return 0.7 // No evidence either way; keep the baseline
+	}
+
+	// Consistency = proportion of value-declaring actions aligned with
+	// core values
+	consistency := float64(alignedCount) / float64(declaredCount)
+
 	return math.Min(1.0, consistency)
 }
 
+// isCoreValue reports whether a value is one of the immutable core values
+func (ct *CoherenceTracker) isCoreValue(value string) bool {
+	for _, coreValue := range ct.coreValues {
+		if value == coreValue {
+			return true
+		}
+	}
+	return false
+}
+
 // calculateAuthenticity measures alignment with core values
 func (ct *CoherenceTracker) calculateAuthenticity() float64 {
 	if len(ct.recentActions) == 0 {
@@ -267,18 +295,18 @@ func (ct *CoherenceTracker) RecordAction(action Action) {
 
 // RecordMemoryEcho records a memory with Deep Tree Echo hooks
 func (ct *CoherenceTracker) RecordMemoryEcho(memory MemoryEcho) {
+	// Compute the embedding outside the lock; provider calls can be slow
+	if memory.EchoSignature == "" {
+		memory.Embedding, memory.EchoSignature = ct.embedMemory(memory.Content)
+	}
+
 	ct.mu.Lock()
 	defer ct.mu.Unlock()
-	
+
 	memory.Timestamp = time.Now()
-	
-	// Generate echo signature if not provided
-	if memory.EchoSignature == "" {
-		memory.EchoSignature = generateEchoSignature(memory.Content)
-	}
-	
+
 	ct.memoryEchos = append(ct.memoryEchos, memory)
-	
+
 	// Keep only recent 10000 memories
 	if len(ct.memoryEchos) > 10000 {
 		ct.memoryEchos = ct.memoryEchos[1:]
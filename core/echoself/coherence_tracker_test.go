@@ -0,0 +1,46 @@
+package echoself
+
+import (
+	"testing"
+)
+
+func TestConsistencyIgnoresValuelessActions(t *testing.T) {
+	ct := NewCoherenceTracker([]string{"curiosity", "integrity"})
+
+	// Actions that never declared values carry no alignment evidence
+	for i := 0; i < 10; i++ {
+		ct.RecordAction(Action{Type: "housekeeping"})
+	}
+
+	if got := ct.calculateConsistency(); got != 0.7 {
+		t.Errorf("valueless actions should keep the baseline, got %f", got)
+	}
+}
+
+func TestConsistencyMixedActions(t *testing.T) {
+	ct := NewCoherenceTracker([]string{"curiosity", "integrity"})
+
+	// 3 aligned, 1 misaligned, 4 neutral: consistency = 3/4
+	ct.RecordAction(Action{Type: "explore", Values: []string{"curiosity"}})
+	ct.RecordAction(Action{Type: "verify", Values: []string{"integrity"}})
+	ct.RecordAction(Action{Type: "explore", Values: []string{"curiosity", "integrity"}})
+	ct.RecordAction(Action{Type: "shortcut", Values: []string{"expedience"}})
+	for i := 0; i < 4; i++ {
+		ct.RecordAction(Action{Type: "housekeeping"})
+	}
+
+	if got := ct.calculateConsistency(); got != 0.75 {
+		t.Errorf("consistency should only count value-declaring actions, got %f", got)
+	}
+}
+
+func TestConsistencyFullyAligned(t *testing.T) {
+	ct := NewCoherenceTracker([]string{"curiosity"})
+
+	ct.RecordAction(Action{Type: "explore", Values: []string{"curiosity"}})
+	ct.RecordAction(Action{Type: "explore", Values: []string{"curiosity"}})
+
+	if got := ct.calculateConsistency(); got != 1.0 {
+		t.Errorf("fully aligned actions should score 1.0, got %f", got)
+	}
+}
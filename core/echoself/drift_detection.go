@@ -0,0 +1,86 @@
+package echoself
+
+import (
+	"fmt"
+	"time"
+)
+
+// DriftEvent describes detected identity drift
+type DriftEvent struct {
+	Timestamp time.Time
+	Magnitude float64
+	Cause     string
+}
+
+// Drift detection compares a recent window of snapshots against the
+// baseline window that precedes it
+const (
+	driftRecentWindow   = 5
+	driftBaselineWindow = 10
+	driftThreshold      = 0.1
+)
+
+// OnDrift registers a callback fired from Update whenever identity drift
+// is detected
+func (ct *CoherenceTracker) OnDrift(callback func(DriftEvent)) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.driftCallback = callback
+}
+
+// DetectDrift reports whether the agent's behavior is drifting away from
+// its core values. It compares the recent authenticity and consistency
+// scores against a baseline window and flags sustained decline, returning
+// the magnitude and a human-readable cause.
+func (ct *CoherenceTracker) DetectDrift() (bool, float64, string) {
+	ct.mu.RLock()
+	defer ct.mu.RUnlock()
+	return ct.detectDrift()
+}
+
+// detectDrift is the lock-free core of DetectDrift, shared with Update
+func (ct *CoherenceTracker) detectDrift() (bool, float64, string) {
+	history := ct.coherenceHistory
+	if len(history) < driftRecentWindow+driftBaselineWindow {
+		return false, 0, "insufficient history"
+	}
+
+	recent := history[len(history)-driftRecentWindow:]
+	baseline := history[len(history)-driftRecentWindow-driftBaselineWindow : len(history)-driftRecentWindow]
+
+	authDrop := meanSnapshotScore(baseline, authenticityOf) - meanSnapshotScore(recent, authenticityOf)
+	consDrop := meanSnapshotScore(baseline, consistencyOf) - meanSnapshotScore(recent, consistencyOf)
+
+	magnitude := 0.0
+	cause := ""
+	switch {
+	case authDrop > driftThreshold && consDrop > driftThreshold:
+		magnitude = (authDrop + consDrop) / 2
+		cause = fmt.Sprintf("authenticity down %.2f and consistency down %.2f against baseline", authDrop, consDrop)
+	case authDrop > driftThreshold:
+		magnitude = authDrop
+		cause = fmt.Sprintf("authenticity down %.2f against baseline: emergent traits no longer support core values", authDrop)
+	case consDrop > driftThreshold:
+		magnitude = consDrop
+		cause = fmt.Sprintf("consistency down %.2f against baseline: recent actions abandon core values", consDrop)
+	default:
+		return false, 0, "no sustained decline"
+	}
+
+	return true, magnitude, cause
+}
+
+func authenticityOf(s CoherenceSnapshot) float64 { return s.AuthenticityScore }
+func consistencyOf(s CoherenceSnapshot) float64  { return s.ConsistencyScore }
+
+// meanSnapshotScore averages a score across a window of snapshots
+func meanSnapshotScore(window []CoherenceSnapshot, score func(CoherenceSnapshot) float64) float64 {
+	if len(window) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, snapshot := range window {
+		sum += score(snapshot)
+	}
+	return sum / float64(len(window))
+}
@@ -0,0 +1,63 @@
+package echoself
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectDriftWhenActionsAbandonCoreValues(t *testing.T) {
+	ct := NewCoherenceTracker([]string{"curiosity", "integrity"})
+
+	var events []DriftEvent
+	ct.OnDrift(func(event DriftEvent) {
+		events = append(events, event)
+	})
+
+	// Build a healthy baseline: actions aligned with core values
+	for i := 0; i < 12; i++ {
+		ct.RecordAction(Action{
+			Type:   "exploration",
+			Values: []string{"curiosity"},
+		})
+		ct.Update()
+	}
+
+	if drifting, _, _ := ct.DetectDrift(); drifting {
+		t.Fatal("aligned behavior should not register as drift")
+	}
+
+	// Recent behavior abandons the core values entirely
+	for i := 0; i < 15; i++ {
+		ct.RecordAction(Action{
+			Type:   "distraction",
+			Values: []string{"novelty-chasing"},
+		})
+		ct.Update()
+	}
+
+	drifting, magnitude, cause := ct.DetectDrift()
+	if !drifting {
+		t.Fatal("abandoning core values should be detected as drift")
+	}
+	if magnitude <= 0 {
+		t.Errorf("drift magnitude should be positive: %f", magnitude)
+	}
+	if !strings.Contains(cause, "consistency") {
+		t.Errorf("cause should name the declining score: %s", cause)
+	}
+	if len(events) == 0 {
+		t.Error("Update should fire the registered drift callback")
+	}
+}
+
+func TestDetectDriftNeedsHistory(t *testing.T) {
+	ct := NewCoherenceTracker([]string{"curiosity"})
+
+	drifting, _, cause := ct.DetectDrift()
+	if drifting {
+		t.Error("fresh tracker should not report drift")
+	}
+	if cause != "insufficient history" {
+		t.Errorf("unexpected cause: %s", cause)
+	}
+}
@@ -0,0 +1,132 @@
+package echoself
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"sort"
+	"time"
+)
+
+// EchoEmbedder is the minimal embedding surface the coherence tracker
+// needs to compute real echo signatures. The deeptreeecho LLM client
+// satisfies this interface via its Embed method.
+type EchoEmbedder interface {
+	Embed(ctx context.Context, texts []string) ([][]float64, error)
+}
+
+// embedTimeout bounds how long a single embedding call may take
+const embedTimeout = 30 * time.Second
+
+// SetEmbedder attaches an embedding provider used to compute 768-d echo
+// signatures for new memories. Without one, signatures fall back to a
+// content hash.
+func (ct *CoherenceTracker) SetEmbedder(embedder EchoEmbedder) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.embedder = embedder
+}
+
+// embedMemory computes the embedding and echo signature for a memory.
+// When no provider is set or the call fails, the embedding is nil and
+// the signature is the content-hash fallback.
+func (ct *CoherenceTracker) embedMemory(content string) ([]float64, string) {
+	ct.mu.RLock()
+	embedder := ct.embedder
+	ct.mu.RUnlock()
+
+	if embedder == nil {
+		return nil, generateEchoSignature(content)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), embedTimeout)
+	defer cancel()
+
+	vectors, err := embedder.Embed(ctx, []string{content})
+	if err != nil || len(vectors) == 0 || len(vectors[0]) == 0 {
+		return nil, generateEchoSignature(content)
+	}
+
+	return vectors[0], quantizeEmbedding(vectors[0])
+}
+
+// quantizeEmbedding derives a stable signature from an embedding by
+// packing the sign bit of each dimension and hashing the result. The
+// same vector always yields the same signature.
+func quantizeEmbedding(vector []float64) string {
+	bits := make([]byte, (len(vector)+7)/8)
+	for i, v := range vector {
+		if v > 0 {
+			bits[i/8] |= 1 << (i % 8)
+		}
+	}
+
+	hash := sha256.Sum256(bits)
+	return hex.EncodeToString(hash[:16])
+}
+
+// cosineSimilarity measures the angle between two embeddings, returning
+// 0 for mismatched or zero-length vectors
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+
+	dot, normA, normB := 0.0, 0.0, 0.0
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// FindSimilarMemories returns the topK recorded memories most similar to
+// the given content by embedding cosine similarity. Memories without
+// embeddings are skipped; without a provider the result is empty.
+func (ct *CoherenceTracker) FindSimilarMemories(content string, topK int) []MemoryEcho {
+	if topK <= 0 {
+		return nil
+	}
+
+	query, _ := ct.embedMemory(content)
+	if query == nil {
+		return nil
+	}
+
+	ct.mu.RLock()
+	type scored struct {
+		memory     MemoryEcho
+		similarity float64
+	}
+	candidates := make([]scored, 0, len(ct.memoryEchos))
+	for _, memory := range ct.memoryEchos {
+		if len(memory.Embedding) == 0 {
+			continue
+		}
+		candidates = append(candidates, scored{
+			memory:     memory,
+			similarity: cosineSimilarity(query, memory.Embedding),
+		})
+	}
+	ct.mu.RUnlock()
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].similarity > candidates[j].similarity
+	})
+	if len(candidates) > topK {
+		candidates = candidates[:topK]
+	}
+
+	results := make([]MemoryEcho, len(candidates))
+	for i, candidate := range candidates {
+		results[i] = candidate.memory
+	}
+
+	return results
+}
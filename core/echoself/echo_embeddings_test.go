@@ -0,0 +1,94 @@
+package echoself
+
+import (
+	"context"
+	"testing"
+)
+
+// stubEmbedder returns deterministic vectors keyed by text
+type stubEmbedder struct {
+	vectors map[string][]float64
+	calls   int
+}
+
+func (s *stubEmbedder) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	s.calls++
+	out := make([][]float64, len(texts))
+	for i, text := range texts {
+		out[i] = s.vectors[text]
+	}
+	return out, nil
+}
+
+func TestRecordMemoryEchoUsesEmbedder(t *testing.T) {
+	ct := NewCoherenceTracker([]string{"curiosity"})
+	embedder := &stubEmbedder{vectors: map[string][]float64{
+		"climbed a tree": {0.5, -0.2, 0.8},
+	}}
+	ct.SetEmbedder(embedder)
+
+	ct.RecordMemoryEcho(MemoryEcho{Content: "climbed a tree"})
+
+	ct.mu.RLock()
+	memory := ct.memoryEchos[0]
+	ct.mu.RUnlock()
+
+	if len(memory.Embedding) != 3 {
+		t.Fatalf("embedding not stored: %v", memory.Embedding)
+	}
+	if memory.EchoSignature != quantizeEmbedding(memory.Embedding) {
+		t.Error("signature should be the quantized embedding")
+	}
+	// The same vector always quantizes to the same signature
+	if quantizeEmbedding([]float64{0.5, -0.2, 0.8}) != memory.EchoSignature {
+		t.Error("quantized signature should be stable")
+	}
+}
+
+func TestRecordMemoryEchoFallsBackToHash(t *testing.T) {
+	ct := NewCoherenceTracker([]string{"curiosity"})
+
+	ct.RecordMemoryEcho(MemoryEcho{Content: "no provider here"})
+
+	ct.mu.RLock()
+	memory := ct.memoryEchos[0]
+	ct.mu.RUnlock()
+
+	if memory.Embedding != nil {
+		t.Error("no embedding expected without a provider")
+	}
+	if memory.EchoSignature != generateEchoSignature("no provider here") {
+		t.Error("signature should fall back to the content hash")
+	}
+}
+
+func TestFindSimilarMemories(t *testing.T) {
+	ct := NewCoherenceTracker([]string{"curiosity"})
+	embedder := &stubEmbedder{vectors: map[string][]float64{
+		"forests at dusk":   {1.0, 0.1, 0.0},
+		"woodland evenings": {0.9, 0.2, 0.1},
+		"stock tickers":     {-0.8, 0.1, 0.9},
+		"trees in twilight": {0.95, 0.15, 0.05},
+	}}
+	ct.SetEmbedder(embedder)
+
+	for _, content := range []string{"woodland evenings", "stock tickers", "trees in twilight"} {
+		ct.RecordMemoryEcho(MemoryEcho{Content: content})
+	}
+
+	similar := ct.FindSimilarMemories("forests at dusk", 2)
+	if len(similar) != 2 {
+		t.Fatalf("expected 2 similar memories, got %d", len(similar))
+	}
+	for _, memory := range similar {
+		if memory.Content == "stock tickers" {
+			t.Error("dissimilar memory ranked in the top results")
+		}
+	}
+
+	// Without a provider similarity search is unavailable
+	cold := NewCoherenceTracker([]string{"curiosity"})
+	if got := cold.FindSimilarMemories("anything", 3); got != nil {
+		t.Errorf("expected nil without an embedder, got %v", got)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/EchoCog/echollama/core/logutil"
 )
 
 // GoalOrchestrator manages autonomous goal generation and pursuit
@@ -33,10 +35,23 @@ type GoalOrchestrator struct {
 	goalsCompleted uint64
 	goalsAbandoned uint64
 
+	// Observers are notified of lifecycle transitions; events are queued
+	// under the lock and delivered outside it
+	observers     []func(GoalEvent)
+	pendingEvents []GoalEvent
+
 	// Control
 	running bool
 }
 
+// GoalEvent describes a goal lifecycle transition
+type GoalEvent struct {
+	Goal      *Goal
+	OldStatus GoalStatus
+	NewStatus GoalStatus
+	Timestamp time.Time
+}
+
 // Goal represents an autonomous goal with progress tracking
 type Goal struct {
 	ID          string     `json:"id"`
@@ -59,12 +74,15 @@ type Goal struct {
 	Milestones      []Milestone `json:"milestones"`
 
 	// Execution
-	Actions    []Action `json:"actions"`
-	NextAction *Action  `json:"next_action,omitempty"`
+	Actions []Action `json:"actions"`
+	// NextActionID references the queued action by ID rather than by
+	// pointer, so appends to Actions cannot leave it dangling
+	NextActionID string `json:"next_action_id,omitempty"`
 
 	// Context
 	DerivedFrom  string                 `json:"derived_from"` // Identity aspect
 	RelatedGoals []string               `json:"related_goals"`
+	DependsOn    []string               `json:"depends_on,omitempty"` // Goal IDs that must complete first
 	Metadata     map[string]interface{} `json:"metadata"`
 
 	// Learning
@@ -85,6 +103,20 @@ const (
 	CategoryConnection        GoalCategory = "connection"
 )
 
+// NextAction resolves the queued action by ID, surviving reallocation of
+// the Actions slice
+func (goal *Goal) NextAction() *Action {
+	if goal.NextActionID == "" {
+		return nil
+	}
+	for i := range goal.Actions {
+		if goal.Actions[i].ID == goal.NextActionID {
+			return &goal.Actions[i]
+		}
+	}
+	return nil
+}
+
 // GoalStatus tracks goal state
 type GoalStatus string
 
@@ -150,7 +182,8 @@ func (g *GoalOrchestrator) Start() error {
 	g.mu.Unlock()
 	
 
-	fmt.Println("🎯 Goal Orchestrator: Starting autonomous goal-directed behavior...")
+	logutil.Logger().Info("starting autonomous goal-directed behavior",
+		logutil.Emoji("🎯"))
 
 	// Start background processes
 	go g.goalGenerationLoop()
@@ -188,7 +221,7 @@ func (g *GoalOrchestrator) Stop() error {
 	// Final persistence
 	g.persistState()
 
-	fmt.Println("🎯 Goal Orchestrator: Stopped")
+	logutil.Logger().Info("goal orchestrator stopped", logutil.Emoji("🎯"))
 
 	return nil
 }
@@ -310,7 +343,11 @@ func (g *GoalOrchestrator) generateGoalsFromIdentity() {
 			g.activeGoals = append(g.activeGoals, goal)
 			g.goalsGenerated++
 
-			fmt.Printf("🎯 Generated new goal: %s (priority: %d)\n", goal.Title, goal.Priority)
+			logutil.Logger().Info("generated new goal",
+				logutil.Emoji("🎯"),
+				"goal_id", goal.ID,
+				"title", goal.Title,
+				"priority", goal.Priority)
 
 			// Only generate one goal per cycle
 			break
@@ -360,27 +397,42 @@ func (g *GoalOrchestrator) goalPursuitLoop() {
 
 // pursueActiveGoals takes actions toward active goals
 func (g *GoalOrchestrator) pursueActiveGoals() {
+	defer g.flushGoalEvents()
+
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	
+
 
 	for _, goal := range g.activeGoals {
 		if goal.Status == StatusPlanned {
+			// Keep the goal blocked until its prerequisites complete
+			if !g.dependenciesSatisfied(goal) {
+				continue
+			}
+
 			// Activate the goal
 			goal.Status = StatusActive
 			goal.UpdatedAt = time.Now()
-			fmt.Printf("🎯 Activating goal: %s\n", goal.Title)
+			g.queueGoalEvent(goal, StatusPlanned, StatusActive)
+			logutil.Logger().Info("activating goal",
+				logutil.Emoji("🎯"),
+				"goal_id", goal.ID,
+				"title", goal.Title)
 		}
 
 		if goal.Status == StatusActive || goal.Status == StatusInProgress {
 			// Check if there's a next action
-			if goal.NextAction == nil && len(goal.Actions) > 0 {
+			if goal.NextActionID == "" && len(goal.Actions) > 0 {
 				// Find next pending action
 				for i := range goal.Actions {
 					if goal.Actions[i].Status == "pending" {
-						goal.NextAction = &goal.Actions[i]
+						goal.NextActionID = goal.Actions[i].ID
 						goal.Status = StatusInProgress
-						fmt.Printf("🎯 Next action for '%s': %s\n", goal.Title, goal.NextAction.Title)
+						logutil.Logger().Info("queued next action",
+							logutil.Emoji("🎯"),
+							"goal_id", goal.ID,
+							"title", goal.Title,
+							"action", goal.Actions[i].Title)
 						break
 					}
 				}
@@ -404,11 +456,25 @@ func (g *GoalOrchestrator) progressMonitoringLoop() {
 	}
 }
 
+// Automatic abandonment thresholds: a goal that has barely moved past
+// its deadline is given up on rather than left to clutter the active set
+const (
+	goalDeadline           = 7 * 24 * time.Hour
+	staleProgressThreshold = 0.1
+)
+
 // updateGoalProgress calculates and updates goal progress
 func (g *GoalOrchestrator) updateGoalProgress() {
+	defer g.flushGoalEvents()
+
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	
+
+	// Collect transitions first: completeGoal/abandonGoal splice elements
+	// out of g.activeGoals, and removing mid-range shifts the backing
+	// array so goals get skipped or revisited within the same cycle
+	var toComplete []*Goal
+	var toAbandon []*Goal
 
 	for _, goal := range g.activeGoals {
 		// Calculate progress based on completed milestones
@@ -423,15 +489,29 @@ func (g *GoalOrchestrator) updateGoalProgress() {
 
 			// Check if goal is complete
 			if goal.Progress >= 1.0 && goal.Status != StatusCompleted {
-				g.completeGoal(goal)
+				toComplete = append(toComplete, goal)
+				continue
 			}
 		}
+
+		// Abandon goals that have stalled past the deadline
+		if goal.Progress < staleProgressThreshold && time.Since(goal.CreatedAt) > goalDeadline {
+			toAbandon = append(toAbandon, goal)
+		}
+	}
+
+	for _, goal := range toComplete {
+		g.completeGoal(goal)
+	}
+	for _, goal := range toAbandon {
+		g.abandonGoal(goal, fmt.Sprintf("no meaningful progress after %v", goalDeadline))
 	}
 }
 
 // completeGoal marks a goal as completed
 func (g *GoalOrchestrator) completeGoal(goal *Goal) {
 	now := time.Now()
+	oldStatus := goal.Status
 	goal.Status = StatusCompleted
 	goal.CompletedAt = &now
 	goal.UpdatedAt = now
@@ -450,7 +530,139 @@ func (g *GoalOrchestrator) completeGoal(goal *Goal) {
 		}
 	}
 
-	fmt.Printf("✅ Goal completed: %s (progress: %.0f%%)\n", goal.Title, goal.Progress*100)
+	g.queueGoalEvent(goal, oldStatus, StatusCompleted)
+
+	logutil.Logger().Info("goal completed",
+		logutil.Emoji("✅"),
+		"goal_id", goal.ID,
+		"title", goal.Title,
+		"progress", goal.Progress)
+}
+
+// OnGoalEvent registers a callback fired on every goal lifecycle
+// transition. Callbacks run outside the orchestrator lock.
+func (g *GoalOrchestrator) OnGoalEvent(callback func(GoalEvent)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.observers = append(g.observers, callback)
+}
+
+// queueGoalEvent records a transition for delivery; caller must hold the
+// lock
+func (g *GoalOrchestrator) queueGoalEvent(goal *Goal, oldStatus, newStatus GoalStatus) {
+	if len(g.observers) == 0 {
+		return
+	}
+	g.pendingEvents = append(g.pendingEvents, GoalEvent{
+		Goal:      goal,
+		OldStatus: oldStatus,
+		NewStatus: newStatus,
+		Timestamp: time.Now(),
+	})
+}
+
+// flushGoalEvents delivers queued events to observers without holding
+// the lock
+func (g *GoalOrchestrator) flushGoalEvents() {
+	g.mu.Lock()
+	events := g.pendingEvents
+	g.pendingEvents = nil
+	observers := g.observers
+	g.mu.Unlock()
+
+	for _, event := range events {
+		for _, observer := range observers {
+			observer(event)
+		}
+	}
+}
+
+// InjectGoal accepts an externally generated goal (e.g. from the
+// interest-driven generator), deduplicating by title
+func (g *GoalOrchestrator) InjectGoal(goal *Goal) error {
+	if goal == nil {
+		return fmt.Errorf("cannot inject nil goal")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, ag := range g.activeGoals {
+		if ag.Title == goal.Title {
+			return fmt.Errorf("goal with title %q already active", goal.Title)
+		}
+	}
+
+	// Give injected goals the same milestone decomposition as
+	// identity-derived ones
+	if len(goal.Milestones) == 0 && len(goal.SuccessCriteria) > 0 {
+		g.decomposGoalIntoMilestones(goal)
+	}
+
+	g.activeGoals = append(g.activeGoals, goal)
+	g.goalsGenerated++
+
+	logutil.Logger().Info("injected goal",
+		logutil.Emoji("🎯"),
+		"goal_id", goal.ID,
+		"title", goal.Title,
+		"priority", goal.Priority)
+
+	return nil
+}
+
+// AbandonGoal gives up on an active goal, recording why
+func (g *GoalOrchestrator) AbandonGoal(goalID, reason string) error {
+	g.mu.Lock()
+
+	var target *Goal
+	for _, goal := range g.activeGoals {
+		if goal.ID == goalID {
+			target = goal
+			break
+		}
+	}
+
+	if target == nil {
+		g.mu.Unlock()
+		return fmt.Errorf("goal %s not found among active goals", goalID)
+	}
+
+	g.abandonGoal(target, reason)
+	g.mu.Unlock()
+
+	g.flushGoalEvents()
+	g.persistState()
+
+	return nil
+}
+
+// abandonGoal marks a goal as abandoned; caller must hold the lock
+func (g *GoalOrchestrator) abandonGoal(goal *Goal, reason string) {
+	oldStatus := goal.Status
+	goal.Status = StatusAbandoned
+	goal.UpdatedAt = time.Now()
+	goal.Challenges = append(goal.Challenges, reason)
+
+	// Move to abandoned goals
+	g.abandonedGoals = append(g.abandonedGoals, goal)
+	g.goalsAbandoned++
+
+	// Remove from active goals
+	for i, ag := range g.activeGoals {
+		if ag.ID == goal.ID {
+			g.activeGoals = append(g.activeGoals[:i], g.activeGoals[i+1:]...)
+			break
+		}
+	}
+
+	g.queueGoalEvent(goal, oldStatus, StatusAbandoned)
+
+	logutil.Logger().Warn("goal abandoned",
+		logutil.Emoji("🚫"),
+		"goal_id", goal.ID,
+		"title", goal.Title,
+		"reason", reason)
 }
 
 // RecordActionResult records the result of an action
@@ -474,11 +686,16 @@ func (g *GoalOrchestrator) RecordActionResult(goalID string, actionID string, re
 					goal.UpdatedAt = now
 
 					// Clear next action
-					if goal.NextAction != nil && goal.NextAction.ID == actionID {
-						goal.NextAction = nil
+					if goal.NextActionID == actionID {
+						goal.NextActionID = ""
 					}
 
-					fmt.Printf("📝 Action result recorded for goal '%s': %s\n", goal.Title, result)
+					logutil.Logger().Info("action result recorded",
+						logutil.Emoji("📝"),
+						"goal_id", goal.ID,
+						"action_id", actionID,
+						"success", success,
+						"result", result)
 					return
 				}
 			}
@@ -486,6 +703,110 @@ func (g *GoalOrchestrator) RecordActionResult(goalID string, actionID string, re
 	}
 }
 
+// dependenciesSatisfied reports whether every goal in DependsOn has
+// completed; caller must hold the lock
+func (g *GoalOrchestrator) dependenciesSatisfied(goal *Goal) bool {
+	for _, depID := range goal.DependsOn {
+		satisfied := false
+		for _, cg := range g.completedGoals {
+			if cg.ID == depID {
+				satisfied = true
+				break
+			}
+		}
+		if !satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+// findGoalLocked looks a goal up by ID across all goal sets; caller must
+// hold the lock
+func (g *GoalOrchestrator) findGoalLocked(goalID string) *Goal {
+	for _, set := range [][]*Goal{g.activeGoals, g.completedGoals, g.abandonedGoals} {
+		for _, goal := range set {
+			if goal.ID == goalID {
+				return goal
+			}
+		}
+	}
+	return nil
+}
+
+// AddDependency declares that a goal must wait for another to complete,
+// rejecting dependencies that would form a cycle
+func (g *GoalOrchestrator) AddDependency(goalID, dependsOnID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if goalID == dependsOnID {
+		return fmt.Errorf("goal %s cannot depend on itself", goalID)
+	}
+
+	goal := g.findGoalLocked(goalID)
+	if goal == nil {
+		return fmt.Errorf("goal %s not found", goalID)
+	}
+
+	dependency := g.findGoalLocked(dependsOnID)
+	if dependency == nil {
+		return fmt.Errorf("dependency goal %s not found", dependsOnID)
+	}
+
+	// Walk the dependency graph from the prerequisite; reaching goalID
+	// again would close a cycle
+	if g.dependsTransitively(dependency, goalID, make(map[string]bool)) {
+		return fmt.Errorf("dependency from %s to %s would create a cycle", goalID, dependsOnID)
+	}
+
+	for _, existing := range goal.DependsOn {
+		if existing == dependsOnID {
+			return nil // Already declared
+		}
+	}
+
+	goal.DependsOn = append(goal.DependsOn, dependsOnID)
+	goal.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// dependsTransitively reports whether goal (directly or indirectly)
+// depends on targetID; caller must hold the lock
+func (g *GoalOrchestrator) dependsTransitively(goal *Goal, targetID string, visited map[string]bool) bool {
+	if visited[goal.ID] {
+		return false
+	}
+	visited[goal.ID] = true
+
+	for _, depID := range goal.DependsOn {
+		if depID == targetID {
+			return true
+		}
+		if dep := g.findGoalLocked(depID); dep != nil {
+			if g.dependsTransitively(dep, targetID, visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetBlockedGoals returns planned goals waiting on unmet dependencies
+func (g *GoalOrchestrator) GetBlockedGoals() []*Goal {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	blocked := make([]*Goal, 0)
+	for _, goal := range g.activeGoals {
+		if goal.Status == StatusPlanned && !g.dependenciesSatisfied(goal) {
+			blocked = append(blocked, goal)
+		}
+	}
+	return blocked
+}
+
 // GetActiveGoals returns current active goals
 func (g *GoalOrchestrator) GetActiveGoals() []*Goal {
 	g.mu.RLock()
@@ -547,32 +868,65 @@ func (g *GoalOrchestrator) persistState() {
 
 	data, err := json.MarshalIndent(state, "", "  ")
 	if err != nil {
-		fmt.Printf("⚠️  Failed to marshal goal state: %v\n", err)
+		logutil.Logger().Warn("failed to marshal goal state",
+			logutil.Emoji("⚠️"), "error", err)
 		return
 	}
 	
 
 	if err := os.WriteFile(g.persistencePath, data, 0644); err != nil {
-		fmt.Printf("⚠️  Failed to persist goal state: %v\n", err)
+		logutil.Logger().Warn("failed to persist goal state",
+			logutil.Emoji("⚠️"), "error", err)
 	}
 }
 
+// persistedGoalState mirrors the envelope written by persistState
+type persistedGoalState struct {
+	ActiveGoals    []*Goal `json:"active_goals"`
+	CompletedGoals []*Goal `json:"completed_goals"`
+	AbandonedGoals []*Goal `json:"abandoned_goals"`
+	GoalsGenerated uint64  `json:"goals_generated"`
+	GoalsCompleted uint64  `json:"goals_completed"`
+	GoalsAbandoned uint64  `json:"goals_abandoned"`
+}
+
 func (g *GoalOrchestrator) loadState() {
 	if g.persistencePath == "" {
 		return
 	}
-	
+
 
 	data, err := os.ReadFile(g.persistencePath)
 	if err != nil {
 		return // File doesn't exist yet
 	}
 
-	var state map[string]interface{}
+	var state persistedGoalState
 	if err := json.Unmarshal(data, &state); err != nil {
-		fmt.Printf("⚠️  Failed to load goal state: %v\n", err)
+		logutil.Logger().Warn("failed to load goal state",
+			logutil.Emoji("⚠️"), "error", err)
 		return
 	}
 
-	fmt.Println("📂 Loaded persisted goal state")
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if state.ActiveGoals != nil {
+		g.activeGoals = state.ActiveGoals
+	}
+	if state.CompletedGoals != nil {
+		g.completedGoals = state.CompletedGoals
+	}
+	if state.AbandonedGoals != nil {
+		g.abandonedGoals = state.AbandonedGoals
+	}
+	g.goalsGenerated = state.GoalsGenerated
+	g.goalsCompleted = state.GoalsCompleted
+	g.goalsAbandoned = state.GoalsAbandoned
+
+	logutil.Logger().Info("loaded persisted goal state",
+		logutil.Emoji("📂"),
+		"active", len(g.activeGoals),
+		"completed", len(g.completedGoals),
+		"abandoned", len(g.abandonedGoals))
 }
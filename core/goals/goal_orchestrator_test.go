@@ -0,0 +1,547 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EchoCog/echollama/core/logutil"
+)
+
+func TestLoadStateRestoresPersistedGoals(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "goals.json")
+
+	first := NewGoalOrchestrator(nil, statePath)
+
+	active := &Goal{
+		ID:          "goal-active-1",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		Title:       "Deepen wisdom practice",
+		Description: "Cultivate wisdom through daily reflection",
+		Category:    CategoryWisdomCultivation,
+		Priority:    8,
+		Status:      StatusInProgress,
+		Progress:    0.4,
+	}
+	completed := &Goal{
+		ID:       "goal-done-1",
+		Title:    "Learn pattern recognition basics",
+		Category: CategorySkillDevelopment,
+		Status:   StatusCompleted,
+		Progress: 1.0,
+	}
+
+	first.mu.Lock()
+	first.activeGoals = append(first.activeGoals, active)
+	first.completedGoals = append(first.completedGoals, completed)
+	first.goalsGenerated = 2
+	first.goalsCompleted = 1
+	first.mu.Unlock()
+
+	first.persistState()
+
+	// A fresh orchestrator pointed at the same file should resume the
+	// in-flight goals, not start empty
+	second := NewGoalOrchestrator(nil, statePath)
+
+	restored := second.GetActiveGoals()
+	if len(restored) != 1 {
+		t.Fatalf("expected 1 active goal after restart, got %d", len(restored))
+	}
+	if restored[0].ID != active.ID || restored[0].Title != active.Title {
+		t.Errorf("active goal not restored, got %+v", restored[0])
+	}
+	if restored[0].Status != StatusInProgress || restored[0].Progress != 0.4 {
+		t.Errorf("goal progress not restored, got status=%s progress=%f", restored[0].Status, restored[0].Progress)
+	}
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+	if len(second.completedGoals) != 1 {
+		t.Errorf("expected 1 completed goal after restart, got %d", len(second.completedGoals))
+	}
+	if second.goalsGenerated != 2 || second.goalsCompleted != 1 {
+		t.Errorf("counters not restored: generated=%d completed=%d", second.goalsGenerated, second.goalsCompleted)
+	}
+}
+
+func TestAbandonGoalMovesGoalAndRecordsReason(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	goal := &Goal{
+		ID:         "goal-1",
+		CreatedAt:  time.Now(),
+		Title:      "Explore a dead end",
+		Status:     StatusActive,
+		Challenges: make([]string, 0),
+	}
+
+	orchestrator.mu.Lock()
+	orchestrator.activeGoals = append(orchestrator.activeGoals, goal)
+	orchestrator.mu.Unlock()
+
+	if err := orchestrator.AbandonGoal("goal-1", "no longer aligned with identity"); err != nil {
+		t.Fatalf("AbandonGoal failed: %v", err)
+	}
+
+	if len(orchestrator.GetActiveGoals()) != 0 {
+		t.Error("abandoned goal should be removed from active goals")
+	}
+	if goal.Status != StatusAbandoned {
+		t.Errorf("expected status %s, got %s", StatusAbandoned, goal.Status)
+	}
+	if len(goal.Challenges) != 1 || goal.Challenges[0] != "no longer aligned with identity" {
+		t.Errorf("reason not recorded in challenges: %v", goal.Challenges)
+	}
+
+	orchestrator.mu.RLock()
+	defer orchestrator.mu.RUnlock()
+	if len(orchestrator.abandonedGoals) != 1 || orchestrator.goalsAbandoned != 1 {
+		t.Errorf("abandoned bookkeeping wrong: %d goals, counter %d",
+			len(orchestrator.abandonedGoals), orchestrator.goalsAbandoned)
+	}
+}
+
+func TestAbandonGoalUnknownID(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	if err := orchestrator.AbandonGoal("missing", "whatever"); err == nil {
+		t.Error("expected error for unknown goal ID")
+	}
+}
+
+func TestGoalEventsFireOnLifecycleTransitions(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	var mu sync.Mutex
+	var events []GoalEvent
+	orchestrator.OnGoalEvent(func(ev GoalEvent) {
+		mu.Lock()
+		events = append(events, ev)
+		mu.Unlock()
+	})
+
+	activating := &Goal{
+		ID:        "goal-activate",
+		CreatedAt: time.Now(),
+		Title:     "Will activate",
+		Status:    StatusPlanned,
+	}
+	completing := &Goal{
+		ID:        "goal-complete",
+		CreatedAt: time.Now(),
+		Title:     "Will complete",
+		Status:    StatusInProgress,
+		Progress:  0.9,
+		Milestones: []Milestone{
+			{ID: "m1", Title: "Only milestone", Completed: true},
+		},
+	}
+	abandoning := &Goal{
+		ID:        "goal-abandon",
+		CreatedAt: time.Now(),
+		Title:     "Will be abandoned",
+		Status:    StatusActive,
+	}
+
+	orchestrator.mu.Lock()
+	orchestrator.activeGoals = append(orchestrator.activeGoals, activating, completing, abandoning)
+	orchestrator.mu.Unlock()
+
+	orchestrator.pursueActiveGoals()
+	orchestrator.updateGoalProgress()
+	if err := orchestrator.AbandonGoal("goal-abandon", "testing"); err != nil {
+		t.Fatalf("AbandonGoal failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	byGoal := make(map[string]GoalEvent)
+	for _, ev := range events {
+		byGoal[ev.Goal.ID] = ev
+	}
+
+	if ev, ok := byGoal["goal-activate"]; !ok || ev.OldStatus != StatusPlanned || ev.NewStatus != StatusActive {
+		t.Errorf("activation event wrong or missing: %+v", ev)
+	}
+	if ev, ok := byGoal["goal-complete"]; !ok || ev.NewStatus != StatusCompleted {
+		t.Errorf("completion event wrong or missing: %+v", ev)
+	}
+	if ev, ok := byGoal["goal-abandon"]; !ok || ev.NewStatus != StatusAbandoned {
+		t.Errorf("abandonment event wrong or missing: %+v", ev)
+	}
+	for _, ev := range events {
+		if ev.Timestamp.IsZero() {
+			t.Error("event timestamp should be set")
+		}
+	}
+}
+
+func TestGoalEventCallbackMayReenterOrchestrator(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	// A callback that re-enters the orchestrator must not deadlock
+	// because events are delivered outside the lock
+	orchestrator.OnGoalEvent(func(ev GoalEvent) {
+		orchestrator.GetMetrics()
+	})
+
+	orchestrator.mu.Lock()
+	orchestrator.activeGoals = append(orchestrator.activeGoals, &Goal{
+		ID:     "goal-1",
+		Title:  "Reentrancy check",
+		Status: StatusPlanned,
+	})
+	orchestrator.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		orchestrator.pursueActiveGoals()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("callback delivery deadlocked")
+	}
+}
+
+func TestEngagementProducesOrchestratorGoal(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+	idgg := NewInterestDrivenGoalGenerator(orchestrator)
+
+	// Repeated engagement makes the topic the strongest interest
+	for i := 0; i < 5; i++ {
+		idgg.RecordEngagement("recursive self-models", 0.2)
+	}
+
+	idgg.generateInterestDrivenGoals()
+
+	var injected *Goal
+	for _, goal := range orchestrator.GetActiveGoals() {
+		if goal.Metadata["topic"] == "recursive self-models" {
+			injected = goal
+			break
+		}
+	}
+	if injected == nil {
+		t.Fatal("engagement should produce an active goal in the orchestrator")
+	}
+	if len(injected.Milestones) == 0 {
+		t.Error("injected goal should be decomposed into milestones")
+	}
+
+	// A second cycle must not duplicate the goal
+	idgg.generateInterestDrivenGoals()
+	count := 0
+	for _, goal := range orchestrator.GetActiveGoals() {
+		if goal.Metadata["topic"] == "recursive self-models" {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("expected exactly 1 goal for the topic, got %d", count)
+	}
+}
+
+func TestNextActionSurvivesActionsReallocation(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	goal := &Goal{
+		ID:     "goal-1",
+		Title:  "Grow the action list",
+		Status: StatusActive,
+		Actions: []Action{
+			{ID: "action-1", Title: "First step", Status: "pending"},
+		},
+	}
+
+	orchestrator.mu.Lock()
+	orchestrator.activeGoals = append(orchestrator.activeGoals, goal)
+	orchestrator.mu.Unlock()
+
+	// Queue the pending action
+	orchestrator.pursueActiveGoals()
+	if goal.NextActionID != "action-1" {
+		t.Fatalf("expected action-1 to be queued, got %q", goal.NextActionID)
+	}
+
+	// Force the Actions backing array to reallocate after queuing
+	orchestrator.mu.Lock()
+	for i := 0; i < 16; i++ {
+		goal.Actions = append(goal.Actions, Action{
+			ID:     fmt.Sprintf("action-extra-%d", i),
+			Status: "pending",
+		})
+	}
+	orchestrator.mu.Unlock()
+
+	next := goal.NextAction()
+	if next == nil || next.ID != "action-1" {
+		t.Fatalf("NextAction should still resolve action-1, got %+v", next)
+	}
+
+	orchestrator.RecordActionResult("goal-1", "action-1", "done", true)
+
+	if goal.Actions[0].Status != "completed" || goal.Actions[0].Result != "done" {
+		t.Errorf("wrong action updated: %+v", goal.Actions[0])
+	}
+	if goal.NextActionID != "" {
+		t.Errorf("completed action should be dequeued, still %q", goal.NextActionID)
+	}
+}
+
+func TestDependenciesBlockActivation(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	prerequisite := &Goal{
+		ID:        "goal-deepen",
+		CreatedAt: time.Now(),
+		Title:     "Deepen understanding",
+		Status:    StatusActive,
+	}
+	dependent := &Goal{
+		ID:        "goal-synthesize",
+		CreatedAt: time.Now(),
+		Title:     "Synthesize knowledge",
+		Status:    StatusPlanned,
+	}
+
+	orchestrator.mu.Lock()
+	orchestrator.activeGoals = append(orchestrator.activeGoals, prerequisite, dependent)
+	orchestrator.mu.Unlock()
+
+	if err := orchestrator.AddDependency("goal-synthesize", "goal-deepen"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+
+	// The dependent goal must stay planned while the prerequisite is open
+	orchestrator.pursueActiveGoals()
+	if dependent.Status != StatusPlanned {
+		t.Errorf("dependent goal should stay planned, got %s", dependent.Status)
+	}
+
+	blocked := orchestrator.GetBlockedGoals()
+	if len(blocked) != 1 || blocked[0].ID != "goal-synthesize" {
+		t.Fatalf("expected the dependent goal to be blocked, got %d blocked goals", len(blocked))
+	}
+
+	// Completing the prerequisite unblocks activation
+	orchestrator.mu.Lock()
+	orchestrator.completeGoal(prerequisite)
+	orchestrator.mu.Unlock()
+
+	orchestrator.pursueActiveGoals()
+	if dependent.Status == StatusPlanned {
+		t.Error("dependent goal should activate once its dependency completes")
+	}
+	if len(orchestrator.GetBlockedGoals()) != 0 {
+		t.Error("no goals should remain blocked after the dependency completes")
+	}
+}
+
+func TestAddDependencyRejectsCycles(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	a := &Goal{ID: "goal-a", Title: "A", Status: StatusPlanned}
+	b := &Goal{ID: "goal-b", Title: "B", Status: StatusPlanned}
+	c := &Goal{ID: "goal-c", Title: "C", Status: StatusPlanned}
+
+	orchestrator.mu.Lock()
+	orchestrator.activeGoals = append(orchestrator.activeGoals, a, b, c)
+	orchestrator.mu.Unlock()
+
+	if err := orchestrator.AddDependency("goal-a", "goal-a"); err == nil {
+		t.Error("self-dependency should be rejected")
+	}
+
+	if err := orchestrator.AddDependency("goal-b", "goal-a"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+	if err := orchestrator.AddDependency("goal-c", "goal-b"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+
+	// a -> c would close the cycle a <- b <- c <- a
+	if err := orchestrator.AddDependency("goal-a", "goal-c"); err == nil {
+		t.Error("transitive cycle should be rejected")
+	}
+
+	if err := orchestrator.AddDependency("goal-b", "missing"); err == nil {
+		t.Error("unknown dependency should be rejected")
+	}
+}
+
+func TestStaleGoalsAbandonedAutomatically(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	stale := &Goal{
+		ID:        "goal-stale",
+		CreatedAt: time.Now().Add(-goalDeadline - time.Hour),
+		Title:     "Stalled exploration",
+		Status:    StatusActive,
+		Progress:  0.05,
+	}
+	healthy := &Goal{
+		ID:        "goal-healthy",
+		CreatedAt: time.Now().Add(-goalDeadline - time.Hour),
+		Title:     "Progressing nicely",
+		Status:    StatusInProgress,
+		Progress:  0.5,
+	}
+
+	orchestrator.mu.Lock()
+	orchestrator.activeGoals = append(orchestrator.activeGoals, stale, healthy)
+	orchestrator.mu.Unlock()
+
+	orchestrator.updateGoalProgress()
+
+	active := orchestrator.GetActiveGoals()
+	if len(active) != 1 || active[0].ID != "goal-healthy" {
+		t.Fatalf("expected only the healthy goal to remain active, got %d goals", len(active))
+	}
+	if stale.Status != StatusAbandoned {
+		t.Errorf("stale goal should be abandoned, got status %s", stale.Status)
+	}
+	if len(stale.Challenges) == 0 {
+		t.Error("automatic abandonment should record a reason in challenges")
+	}
+}
+
+func TestUpdateGoalProgressTransitionsEveryStaleGoal(t *testing.T) {
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	// Several consecutive stale goals used to trip over the in-range
+	// splice in completeGoal/abandonGoal: removing element i shifted the
+	// rest of the slice so the next goal was skipped and the last slot
+	// revisited, abandoning a goal twice
+	orchestrator.mu.Lock()
+	for i := 0; i < 5; i++ {
+		orchestrator.activeGoals = append(orchestrator.activeGoals, &Goal{
+			ID:        fmt.Sprintf("goal-stale-%d", i),
+			CreatedAt: time.Now().Add(-goalDeadline - time.Hour),
+			Title:     "Stalled exploration",
+			Status:    StatusActive,
+			Progress:  0.05,
+		})
+	}
+	orchestrator.mu.Unlock()
+
+	orchestrator.updateGoalProgress()
+
+	if active := orchestrator.GetActiveGoals(); len(active) != 0 {
+		t.Fatalf("every stale goal should be abandoned in one cycle, %d remain", len(active))
+	}
+
+	orchestrator.mu.RLock()
+	defer orchestrator.mu.RUnlock()
+	if len(orchestrator.abandonedGoals) != 5 {
+		t.Fatalf("expected 5 abandoned goals, got %d", len(orchestrator.abandonedGoals))
+	}
+	seen := make(map[string]bool)
+	for _, goal := range orchestrator.abandonedGoals {
+		if seen[goal.ID] {
+			t.Errorf("goal %s abandoned twice", goal.ID)
+		}
+		seen[goal.ID] = true
+	}
+	if orchestrator.goalsAbandoned != 5 {
+		t.Errorf("goalsAbandoned metric should be 5, got %d", orchestrator.goalsAbandoned)
+	}
+}
+
+// recordingHandler captures slog records so tests can assert on the
+// attributes the orchestrator logs
+type recordingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordingHandler) Handle(_ context.Context, record slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, record.Clone())
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *recordingHandler) WithGroup(string) slog.Handler      { return h }
+
+func (h *recordingHandler) find(message string) (slog.Record, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, record := range h.records {
+		if record.Message == message {
+			return record, true
+		}
+	}
+	return slog.Record{}, false
+}
+
+func attrValue(record slog.Record, key string) (string, bool) {
+	value := ""
+	found := false
+	record.Attrs(func(attr slog.Attr) bool {
+		if attr.Key == key {
+			value = attr.Value.String()
+			found = true
+			return false
+		}
+		return true
+	})
+	return value, found
+}
+
+func TestStructuredLogsCarryGoalAttributes(t *testing.T) {
+	recorder := &recordingHandler{}
+	logutil.SetLogger(slog.New(recorder))
+	t.Cleanup(func() { logutil.SetLogger(nil) })
+
+	orchestrator := NewGoalOrchestrator(nil, "")
+
+	goal := &Goal{
+		ID:        "goal-logged-1",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Title:     "Trace goal lifecycle through logs",
+		Priority:  6,
+		Status:    StatusPlanned,
+	}
+	if err := orchestrator.InjectGoal(goal); err != nil {
+		t.Fatalf("InjectGoal failed: %v", err)
+	}
+	if err := orchestrator.AbandonGoal(goal.ID, "testing abandonment"); err != nil {
+		t.Fatalf("AbandonGoal failed: %v", err)
+	}
+
+	injected, ok := recorder.find("injected goal")
+	if !ok {
+		t.Fatal("expected an 'injected goal' log record")
+	}
+	if id, _ := attrValue(injected, "goal_id"); id != goal.ID {
+		t.Errorf("injected record should carry goal_id, got %q", id)
+	}
+	if _, ok := attrValue(injected, "priority"); !ok {
+		t.Error("injected record should carry the goal priority")
+	}
+
+	abandoned, ok := recorder.find("goal abandoned")
+	if !ok {
+		t.Fatal("expected a 'goal abandoned' log record")
+	}
+	if abandoned.Level != slog.LevelWarn {
+		t.Errorf("abandonment should log at warn level, got %v", abandoned.Level)
+	}
+	if reason, _ := attrValue(abandoned, "reason"); reason != "testing abandonment" {
+		t.Errorf("abandoned record should carry the reason, got %q", reason)
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/EchoCog/echollama/core/logutil"
+	"github.com/EchoCog/echollama/core/persistence"
+	"github.com/EchoCog/echollama/core/randutil"
 )
 
 // InterestDrivenGoalGenerator creates goals based on curiosity and interest patterns
@@ -35,6 +38,10 @@ type InterestDrivenGoalGenerator struct {
 	minInterestThreshold float64
 	maxGoalsPerCycle    int
 	generationInterval  time.Duration
+
+	// Wake/rest gating: interests decay while awake and consolidate
+	// (strengthen) during rest
+	awake               bool
 	
 	// Metrics
 	goalsGenerated      uint64
@@ -58,10 +65,12 @@ type InterestPattern struct {
 	RelatedTopics   []string
 }
 
-// NewInterestDrivenGoalGenerator creates a new interest-driven goal generator
-func NewInterestDrivenGoalGenerator(goalOrchestrator *GoalOrchestrator) *InterestDrivenGoalGenerator {
+// NewInterestDrivenGoalGenerator creates a new interest-driven goal
+// generator. When a persistence store is supplied, previously saved
+// interest patterns are restored from it.
+func NewInterestDrivenGoalGenerator(goalOrchestrator *GoalOrchestrator, store ...persistence.Store) *InterestDrivenGoalGenerator {
 	ctx, cancel := context.WithCancel(context.Background())
-	
+
 	idgg := &InterestDrivenGoalGenerator{
 		ctx:                  ctx,
 		cancel:               cancel,
@@ -76,14 +85,87 @@ func NewInterestDrivenGoalGenerator(goalOrchestrator *GoalOrchestrator) *Interes
 		minInterestThreshold: 0.4,
 		maxGoalsPerCycle:     3,
 		generationInterval:   5 * time.Minute,
+		awake:                true,
 	}
 	
 	// Initialize with seed interests
 	idgg.initializeSeedInterests()
-	
+
+	// Restore persisted curiosity state over the seeds, if available
+	if len(store) > 0 && store[0] != nil {
+		idgg.LoadState(store[0])
+	}
+
 	return idgg
 }
 
+// interestStateKey is the state key interest patterns persist under
+const interestStateKey = "interest_generator_state"
+
+// interestGeneratorState is the envelope saved through the store's
+// key-value state API
+type interestGeneratorState struct {
+	InterestPatterns map[string]*InterestPattern `json:"interest_patterns"`
+	CuriosityLevel   float64                     `json:"curiosity_level"`
+	ExplorationRate  float64                     `json:"exploration_rate"`
+	ExploredTopics   []string                    `json:"explored_topics"`
+}
+
+// SaveState persists interest patterns and curiosity parameters so the
+// agent remembers what it was curious about across restarts
+func (idgg *InterestDrivenGoalGenerator) SaveState(store persistence.Store) error {
+	idgg.mu.RLock()
+
+	state := interestGeneratorState{
+		InterestPatterns: make(map[string]*InterestPattern, len(idgg.interestPatterns)),
+		CuriosityLevel:   idgg.curiosityLevel,
+		ExplorationRate:  idgg.explorationRate,
+		ExploredTopics:   make([]string, 0, len(idgg.exploredTopics)),
+	}
+	for topic, pattern := range idgg.interestPatterns {
+		copied := *pattern
+		state.InterestPatterns[topic] = &copied
+	}
+	for topic := range idgg.exploredTopics {
+		state.ExploredTopics = append(state.ExploredTopics, topic)
+	}
+
+	idgg.mu.RUnlock()
+
+	if err := store.SaveState(interestStateKey, state); err != nil {
+		return fmt.Errorf("failed to save interest state: %w", err)
+	}
+
+	return nil
+}
+
+// LoadState restores interest patterns and curiosity parameters saved
+// by SaveState. A missing key is not an error; the seeds stand.
+func (idgg *InterestDrivenGoalGenerator) LoadState(store persistence.Store) error {
+	var state interestGeneratorState
+	if err := store.GetState(interestStateKey, &state); err != nil {
+		return fmt.Errorf("failed to load interest state: %w", err)
+	}
+
+	idgg.mu.Lock()
+	defer idgg.mu.Unlock()
+
+	if state.InterestPatterns != nil {
+		idgg.interestPatterns = state.InterestPatterns
+	}
+	if state.CuriosityLevel > 0 {
+		idgg.curiosityLevel = state.CuriosityLevel
+	}
+	if state.ExplorationRate > 0 {
+		idgg.explorationRate = state.ExplorationRate
+	}
+	for _, topic := range state.ExploredTopics {
+		idgg.exploredTopics[topic] = true
+	}
+
+	return nil
+}
+
 // initializeSeedInterests creates initial interest patterns
 func (idgg *InterestDrivenGoalGenerator) initializeSeedInterests() {
 	seedTopics := []string{
@@ -102,12 +184,12 @@ func (idgg *InterestDrivenGoalGenerator) initializeSeedInterests() {
 	for _, topic := range seedTopics {
 		idgg.interestPatterns[topic] = &InterestPattern{
 			Topic:           topic,
-			Strength:        0.5 + rand.Float64()*0.3,
+			Strength:        0.5 + randutil.Float64()*0.3,
 			Recency:         0.5,
 			Depth:           0.2,
 			Novelty:         0.8,
 			Utility:         0.6,
-			LastEngaged:     time.Now().Add(-time.Duration(rand.Intn(24)) * time.Hour),
+			LastEngaged:     time.Now().Add(-time.Duration(randutil.Intn(24)) * time.Hour),
 			EngagementCount: 0,
 			RelatedTopics:   make([]string, 0),
 		}
@@ -172,15 +254,29 @@ func (idgg *InterestDrivenGoalGenerator) generateInterestDrivenGoals() {
 	// Generate goals for each strong interest
 	for _, interest := range strongInterests {
 		goal := idgg.createGoalFromInterest(interest)
-		if goal != nil {
-			// Store goal (orchestrator manages its own goals)
-			// In real implementation, would integrate with orchestrator's goal generation
-			
-			idgg.mu.Lock()
-			idgg.generatedGoals = append(idgg.generatedGoals, goal)
-			idgg.goalsGenerated++
-			idgg.mu.Unlock()
+		if goal == nil {
+			continue
 		}
+
+		// Hand the goal to the orchestrator so it actually gets pursued;
+		// duplicates by title are rejected there
+		if idgg.goalOrchestrator != nil {
+			if err := idgg.goalOrchestrator.InjectGoal(goal); err != nil {
+				continue
+			}
+		}
+
+		idgg.mu.Lock()
+		idgg.generatedGoals = append(idgg.generatedGoals, goal)
+		idgg.goalsGenerated++
+		idgg.mu.Unlock()
+
+		logutil.Logger().Info("interest-driven goal generated",
+			logutil.Emoji("💡"),
+			"goal_id", goal.ID,
+			"topic", interest.Topic,
+			"goal_type", goal.Metadata["type"],
+			"priority", goal.Priority)
 	}
 }
 
@@ -306,17 +402,55 @@ func (idgg *InterestDrivenGoalGenerator) createGoalFromInterest(interest *Intere
 	return goal
 }
 
-// interestDecayLoop gradually decays interest over time
+// SetWakeState gates the interest schedule. Wire this to the wake/rest
+// controller's state callback: true while awake, false during rest.
+func (idgg *InterestDrivenGoalGenerator) SetWakeState(awake bool) {
+	idgg.mu.Lock()
+	defer idgg.mu.Unlock()
+	idgg.awake = awake
+}
+
+// interestDecayLoop runs the interest schedule: decay while awake,
+// consolidation during rest
 func (idgg *InterestDrivenGoalGenerator) interestDecayLoop() {
 	ticker := time.NewTicker(1 * time.Hour)
 	defer ticker.Stop()
-	
+
 	for {
 		select {
 		case <-idgg.ctx.Done():
 			return
 		case <-ticker.C:
-			idgg.decayInterests()
+			idgg.applyInterestSchedule()
+		}
+	}
+}
+
+// applyInterestSchedule decays or consolidates interests depending on
+// the current wake state
+func (idgg *InterestDrivenGoalGenerator) applyInterestSchedule() {
+	idgg.mu.RLock()
+	awake := idgg.awake
+	idgg.mu.RUnlock()
+
+	if awake {
+		idgg.decayInterests()
+	} else {
+		idgg.consolidateInterests()
+	}
+}
+
+// consolidateInterests strengthens engaged interests during rest,
+// modeling memory consolidation rather than forgetting
+func (idgg *InterestDrivenGoalGenerator) consolidateInterests() {
+	idgg.mu.Lock()
+	defer idgg.mu.Unlock()
+
+	for _, pattern := range idgg.interestPatterns {
+		// Interests that were engaged recently are reinforced
+		if pattern.EngagementCount > 0 {
+			pattern.Strength = clamp(pattern.Strength*1.02, 0.0, 1.0)
+			pattern.Depth = clamp(pattern.Depth*1.01, 0.0, 1.0)
 		}
 	}
 }
@@ -325,7 +459,7 @@ func (idgg *InterestDrivenGoalGenerator) interestDecayLoop() {
 func (idgg *InterestDrivenGoalGenerator) decayInterests() {
 	idgg.mu.Lock()
 	defer idgg.mu.Unlock()
-	
+
 	for _, pattern := range idgg.interestPatterns {
 		// Decay strength over time if not engaged
 		timeSinceEngagement := time.Since(pattern.LastEngaged)
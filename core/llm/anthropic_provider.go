@@ -17,6 +17,8 @@ type AnthropicProvider struct {
 	model      string
 	apiURL     string
 	httpClient *http.Client
+	retry      RetryConfig
+	usage      *UsageTracker
 }
 
 // NewAnthropicProvider creates a new Anthropic provider
@@ -30,9 +32,28 @@ func NewAnthropicProvider(model string) *AnthropicProvider {
 		model:      model,
 		apiURL:     "https://api.anthropic.com/v1/messages",
 		httpClient: &http.Client{},
+		retry:      DefaultRetryConfig(),
 	}
 }
 
+// SetRetryConfig overrides the retry behaviour for API calls
+func (ap *AnthropicProvider) SetRetryConfig(cfg RetryConfig) {
+	ap.retry = cfg
+}
+
+// SetUsageTracker wires a tracker that accumulates token usage per call
+func (ap *AnthropicProvider) SetUsageTracker(tracker *UsageTracker) {
+	ap.usage = tracker
+}
+
+// recordUsage forwards token counts to the tracker when one is attached
+func (ap *AnthropicProvider) recordUsage(apiResp *anthropicResponse) {
+	if ap.usage == nil {
+		return
+	}
+	ap.usage.Record(ap.model, apiResp.Usage.InputTokens, apiResp.Usage.OutputTokens)
+}
+
 // Name returns the provider name
 func (ap *AnthropicProvider) Name() string {
 	return "anthropic"
@@ -116,48 +137,56 @@ func (ap *AnthropicProvider) Generate(ctx context.Context, prompt string, opts G
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
 	
-	// Create HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", ap.apiURL, bytes.NewReader(reqBody))
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
-	}
-	
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("x-api-key", ap.apiKey)
-	httpReq.Header.Set("anthropic-version", "2023-06-01")
-	
-	// Send request
-	resp, err := ap.httpClient.Do(httpReq)
+	// Send request, retrying transient failures
+	resp, err := doWithRetry(ctx, ap.httpClient, ap.retry, func() (*http.Request, error) {
+		return ap.newAPIRequest(ctx, reqBody)
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
-	
+
 	// Read response
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return "", fmt.Errorf("failed to read response: %w", err)
 	}
-	
+
 	// Check for errors
 	if resp.StatusCode != http.StatusOK {
 		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
 	}
-	
+
 	// Parse response
 	var apiResp anthropicResponse
 	if err := json.Unmarshal(body, &apiResp); err != nil {
 		return "", fmt.Errorf("failed to parse response: %w", err)
 	}
-	
+
+	ap.recordUsage(&apiResp)
+
 	// Extract text
 	if len(apiResp.Content) == 0 {
 		return "", fmt.Errorf("no content in response")
 	}
-	
+
 	return apiResp.Content[0].Text, nil
 }
 
+// newAPIRequest builds a Messages API request with the standard headers
+func (ap *AnthropicProvider) newAPIRequest(ctx context.Context, reqBody []byte) (*http.Request, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", ap.apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", ap.apiKey)
+	httpReq.Header.Set("anthropic-version", "2023-06-01")
+
+	return httpReq, nil
+}
+
 // StreamGenerate produces a streaming completion
 func (ap *AnthropicProvider) StreamGenerate(ctx context.Context, prompt string, opts GenerateOptions) (<-chan StreamChunk, error) {
 	outChan := make(chan StreamChunk, 10)
@@ -299,16 +328,9 @@ func (ap *AnthropicProvider) GenerateWithMessages(ctx context.Context, messages
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
 	
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", ap.apiURL, bytes.NewReader(reqBody))
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
-	}
-	
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("x-api-key", ap.apiKey)
-	httpReq.Header.Set("anthropic-version", "2023-06-01")
-	
-	resp, err := ap.httpClient.Do(httpReq)
+	resp, err := doWithRetry(ctx, ap.httpClient, ap.retry, func() (*http.Request, error) {
+		return ap.newAPIRequest(ctx, reqBody)
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to send request: %w", err)
 	}
@@ -328,10 +350,12 @@ func (ap *AnthropicProvider) GenerateWithMessages(ctx context.Context, messages
 		return "", fmt.Errorf("failed to parse response: %w", err)
 	}
 	
+	ap.recordUsage(&apiResp)
+
 	if len(apiResp.Content) == 0 {
 		return "", fmt.Errorf("no content in response")
 	}
-	
+
 	// Concatenate all text blocks
 	var result strings.Builder
 	for _, content := range apiResp.Content {
@@ -16,6 +16,7 @@ type OpenAIProvider struct {
 	model      string
 	apiURL     string
 	httpClient *http.Client
+	retry      RetryConfig
 }
 
 // NewOpenAIProvider creates a new OpenAI provider
@@ -33,9 +34,15 @@ func NewOpenAIProvider(apiKey string) *OpenAIProvider {
 		model:      model,
 		apiURL:     baseURL + "/chat/completions",
 		httpClient: &http.Client{},
+		retry:      DefaultRetryConfig(),
 	}
 }
 
+// SetRetryConfig overrides the retry behaviour for API calls
+func (oai *OpenAIProvider) SetRetryConfig(cfg RetryConfig) {
+	oai.retry = cfg
+}
+
 // Name returns the provider name
 func (oai *OpenAIProvider) Name() string {
 	return "openai"
@@ -127,17 +134,18 @@ func (oai *OpenAIProvider) Generate(ctx context.Context, prompt string, opts Gen
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
 	
-	// Create HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", oai.apiURL, bytes.NewReader(reqBody))
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
-	}
-	
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Authorization", "Bearer "+oai.apiKey)
-	
-	// Send request
-	resp, err := oai.httpClient.Do(httpReq)
+	// Send request, retrying transient failures
+	resp, err := doWithRetry(ctx, oai.httpClient, oai.retry, func() (*http.Request, error) {
+		httpReq, err := http.NewRequestWithContext(ctx, "POST", oai.apiURL, bytes.NewReader(reqBody))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Authorization", "Bearer "+oai.apiKey)
+
+		return httpReq, nil
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to send request: %w", err)
 	}
@@ -0,0 +1,107 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig controls how provider API calls are retried on transient
+// failures
+type RetryConfig struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+// DefaultRetryConfig returns the retry behaviour providers start with
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+	}
+}
+
+// isRetryableStatus reports whether an HTTP status indicates a transient
+// condition worth retrying
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, // 429
+		http.StatusInternalServerError, // 500
+		http.StatusBadGateway,          // 502
+		http.StatusServiceUnavailable,  // 503
+		529:                            // Anthropic overloaded
+		return true
+	}
+	return false
+}
+
+// backoffDelay computes the exponential backoff for an attempt, with up
+// to 50% random jitter to avoid thundering herds
+func backoffDelay(base time.Duration, attempt int) time.Duration {
+	delay := base << uint(attempt)
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay + jitter
+}
+
+// retryAfterDelay honors a Retry-After header (in seconds) when the
+// server provides one, falling back to the computed backoff
+func retryAfterDelay(resp *http.Response, fallback time.Duration) time.Duration {
+	if header := resp.Header.Get("Retry-After"); header != "" {
+		if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return fallback
+}
+
+// doWithRetry executes an HTTP request with retries on connection errors
+// and retryable statuses. newRequest is called per attempt so the request
+// body can be re-read.
+func doWithRetry(ctx context.Context, client *http.Client, cfg RetryConfig, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = 500 * time.Millisecond
+	}
+
+	var lastErr error
+	delay := time.Duration(0)
+
+	for attempt := 0; attempt < cfg.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			lastErr = err
+			delay = backoffDelay(cfg.BaseDelay, attempt)
+			continue
+		}
+
+		if !isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		lastErr = fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		delay = retryAfterDelay(resp, backoffDelay(cfg.BaseDelay, attempt))
+	}
+
+	return nil, fmt.Errorf("request failed after %d attempts: %w", cfg.MaxAttempts, lastErr)
+}
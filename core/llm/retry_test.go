@@ -0,0 +1,107 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGenerateRetriesAfter429(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, `{"error":{"type":"rate_limit_error"}}`, http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"content":[{"type":"text","text":"recovered"}],"stop_reason":"end_turn"}`)
+	}))
+	defer server.Close()
+
+	ap := NewAnthropicProvider("claude-3-5-sonnet-20241022")
+	ap.apiKey = "test-key"
+	ap.apiURL = server.URL
+	ap.SetRetryConfig(RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond})
+
+	result, err := ap.Generate(context.Background(), "hello", GenerateOptions{MaxTokens: 10})
+	if err != nil {
+		t.Fatalf("Generate should succeed after retry: %v", err)
+	}
+	if result != "recovered" {
+		t.Errorf("unexpected result: %q", result)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestDoWithRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		http.Error(w, "overloaded", 529)
+	}))
+	defer server.Close()
+
+	cfg := RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	_, err := doWithRetry(context.Background(), server.Client(), cfg, func() (*http.Request, error) {
+		return http.NewRequest("GET", server.URL, nil)
+	})
+	if err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestDoWithRetryDoesNotRetryClientErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		http.Error(w, "bad request", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	cfg := RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	resp, err := doWithRetry(context.Background(), server.Client(), cfg, func() (*http.Request, error) {
+		return http.NewRequest("GET", server.URL, nil)
+	})
+	if err != nil {
+		t.Fatalf("non-retryable statuses should be returned to the caller: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("400 should not be retried, got %d attempts", got)
+	}
+}
+
+func TestDoWithRetryRecoversFromConnectionErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	var attempts int32
+	cfg := RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	resp, err := doWithRetry(context.Background(), server.Client(), cfg, func() (*http.Request, error) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			// First attempt targets a closed port to force a connection error
+			return http.NewRequest("GET", "http://127.0.0.1:1", nil)
+		}
+		return http.NewRequest("GET", server.URL, nil)
+	})
+	if err != nil {
+		t.Fatalf("expected recovery from connection error: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
@@ -0,0 +1,108 @@
+package llm
+
+import (
+	"sync"
+)
+
+// ModelPricing holds per-million-token prices in USD for a model
+type ModelPricing struct {
+	PromptPerMillion     float64
+	CompletionPerMillion float64
+}
+
+// defaultPricing seeds the tracker with prices for common models;
+// operators can override or extend via SetPricing
+func defaultPricing() map[string]ModelPricing {
+	return map[string]ModelPricing{
+		"claude-3-5-sonnet-20241022": {PromptPerMillion: 3.00, CompletionPerMillion: 15.00},
+		"gpt-4.1-mini":               {PromptPerMillion: 0.40, CompletionPerMillion: 1.60},
+		"gpt-4o":                     {PromptPerMillion: 2.50, CompletionPerMillion: 10.00},
+	}
+}
+
+// modelUsage accumulates token counts for a single model
+type modelUsage struct {
+	promptTokens     int64
+	completionTokens int64
+}
+
+// UsageTracker accumulates token usage per model across a session and
+// estimates cost from a configurable price table. Safe for concurrent use.
+type UsageTracker struct {
+	mu      sync.RWMutex
+	usage   map[string]*modelUsage
+	pricing map[string]ModelPricing
+}
+
+// NewUsageTracker creates a tracker seeded with default model pricing
+func NewUsageTracker() *UsageTracker {
+	return &UsageTracker{
+		usage:   make(map[string]*modelUsage),
+		pricing: defaultPricing(),
+	}
+}
+
+// Record accumulates token counts for a model
+func (ut *UsageTracker) Record(model string, promptTokens, completionTokens int) {
+	ut.mu.Lock()
+	defer ut.mu.Unlock()
+
+	usage, exists := ut.usage[model]
+	if !exists {
+		usage = &modelUsage{}
+		ut.usage[model] = usage
+	}
+
+	usage.promptTokens += int64(promptTokens)
+	usage.completionTokens += int64(completionTokens)
+}
+
+// SetPricing overrides or adds pricing for a model
+func (ut *UsageTracker) SetPricing(model string, pricing ModelPricing) {
+	ut.mu.Lock()
+	defer ut.mu.Unlock()
+
+	ut.pricing[model] = pricing
+}
+
+// estimateCost computes the USD cost for a model's accumulated usage;
+// unknown models cost zero
+func (ut *UsageTracker) estimateCost(model string, usage *modelUsage) float64 {
+	pricing, known := ut.pricing[model]
+	if !known {
+		return 0
+	}
+
+	return float64(usage.promptTokens)/1e6*pricing.PromptPerMillion +
+		float64(usage.completionTokens)/1e6*pricing.CompletionPerMillion
+}
+
+// GetUsageStats returns per-model token counts and cost estimates plus
+// session totals
+func (ut *UsageTracker) GetUsageStats() map[string]interface{} {
+	ut.mu.RLock()
+	defer ut.mu.RUnlock()
+
+	models := make(map[string]interface{}, len(ut.usage))
+	var totalTokens int64
+	var totalCost float64
+
+	for model, usage := range ut.usage {
+		cost := ut.estimateCost(model, usage)
+		totalTokens += usage.promptTokens + usage.completionTokens
+		totalCost += cost
+
+		models[model] = map[string]interface{}{
+			"prompt_tokens":      usage.promptTokens,
+			"completion_tokens":  usage.completionTokens,
+			"total_tokens":       usage.promptTokens + usage.completionTokens,
+			"estimated_cost_usd": cost,
+		}
+	}
+
+	return map[string]interface{}{
+		"models":             models,
+		"total_tokens":       totalTokens,
+		"estimated_cost_usd": totalCost,
+	}
+}
@@ -0,0 +1,86 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestUsageTrackerAccumulatesAndEstimatesCost(t *testing.T) {
+	tracker := NewUsageTracker()
+	tracker.SetPricing("test-model", ModelPricing{PromptPerMillion: 1.00, CompletionPerMillion: 2.00})
+
+	tracker.Record("test-model", 500000, 250000)
+	tracker.Record("test-model", 500000, 250000)
+	tracker.Record("unknown-model", 1000, 1000)
+
+	stats := tracker.GetUsageStats()
+
+	models := stats["models"].(map[string]interface{})
+	testModel := models["test-model"].(map[string]interface{})
+
+	if testModel["prompt_tokens"].(int64) != 1000000 {
+		t.Errorf("prompt tokens wrong: %v", testModel["prompt_tokens"])
+	}
+	if testModel["completion_tokens"].(int64) != 500000 {
+		t.Errorf("completion tokens wrong: %v", testModel["completion_tokens"])
+	}
+	// 1M prompt @ $1/M + 0.5M completion @ $2/M = $2.00
+	if cost := testModel["estimated_cost_usd"].(float64); cost < 1.99 || cost > 2.01 {
+		t.Errorf("cost estimate wrong: %f", cost)
+	}
+
+	// Unknown models are tracked but cost nothing
+	unknown := models["unknown-model"].(map[string]interface{})
+	if unknown["estimated_cost_usd"].(float64) != 0 {
+		t.Error("unknown model should cost zero")
+	}
+
+	if stats["total_tokens"].(int64) != 1502000 {
+		t.Errorf("total tokens wrong: %v", stats["total_tokens"])
+	}
+}
+
+func TestUsageTrackerConcurrentRecording(t *testing.T) {
+	tracker := NewUsageTracker()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tracker.Record("model-a", 10, 5)
+		}()
+	}
+	wg.Wait()
+
+	stats := tracker.GetUsageStats()
+	if stats["total_tokens"].(int64) != 50*15 {
+		t.Errorf("concurrent recording lost tokens: %v", stats["total_tokens"])
+	}
+}
+
+func TestAnthropicProviderRecordsUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"content":[{"type":"text","text":"ok"}],"usage":{"input_tokens":120,"output_tokens":30}}`)
+	}))
+	defer server.Close()
+
+	tracker := NewUsageTracker()
+	ap := NewAnthropicProvider("claude-3-5-sonnet-20241022")
+	ap.apiKey = "test-key"
+	ap.apiURL = server.URL
+	ap.SetUsageTracker(tracker)
+
+	if _, err := ap.Generate(context.Background(), "hi", GenerateOptions{MaxTokens: 10}); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	stats := tracker.GetUsageStats()
+	if stats["total_tokens"].(int64) != 150 {
+		t.Errorf("provider did not record usage: %v", stats["total_tokens"])
+	}
+}
@@ -0,0 +1,122 @@
+// Package logutil centralizes structured logging for the autonomous
+// subsystems. Code logs through the shared Logger, which defaults to a
+// text handler so records can be filtered and shipped to aggregators;
+// deployments that want the historical emoji output can install a
+// PrettyHandler instead via SetLogger.
+package logutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// EmojiKey is the attribute key the PrettyHandler promotes to a message
+// prefix; structured handlers carry it as an ordinary attribute
+const EmojiKey = "emoji"
+
+// Emoji tags a record with the symbol the pretty handler prefixes to
+// the message
+func Emoji(symbol string) slog.Attr {
+	return slog.String(EmojiKey, symbol)
+}
+
+var (
+	mu     sync.RWMutex
+	logger = newDefaultLogger()
+)
+
+func newDefaultLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+// Logger returns the shared logger
+func Logger() *slog.Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return logger
+}
+
+// SetLogger replaces the shared logger; passing nil restores the
+// default text handler
+func SetLogger(l *slog.Logger) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if l == nil {
+		logger = newDefaultLogger()
+		return
+	}
+	logger = l
+}
+
+// PrettyHandler renders records in the human-oriented style the
+// subsystems printed before structured logging: an emoji prefix, the
+// message, then key=value attributes in parentheses
+type PrettyHandler struct {
+	w     io.Writer
+	mu    *sync.Mutex
+	level slog.Leveler
+	attrs []slog.Attr
+}
+
+// NewPrettyHandler creates a pretty handler writing to w at Info level
+func NewPrettyHandler(w io.Writer) *PrettyHandler {
+	return &PrettyHandler{
+		w:     w,
+		mu:    &sync.Mutex{},
+		level: slog.LevelInfo,
+	}
+}
+
+// Enabled reports whether records at the given level are emitted
+func (h *PrettyHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+// Handle writes the record as a single emoji-prefixed line
+func (h *PrettyHandler) Handle(_ context.Context, record slog.Record) error {
+	emoji := ""
+	parts := make([]string, 0, record.NumAttrs()+len(h.attrs))
+	collect := func(attr slog.Attr) bool {
+		if attr.Key == EmojiKey {
+			emoji = attr.Value.String()
+			return true
+		}
+		parts = append(parts, fmt.Sprintf("%s=%v", attr.Key, attr.Value))
+		return true
+	}
+	for _, attr := range h.attrs {
+		collect(attr)
+	}
+	record.Attrs(collect)
+
+	line := record.Message
+	if emoji != "" {
+		line = emoji + " " + line
+	}
+	if len(parts) > 0 {
+		line += " (" + strings.Join(parts, ", ") + ")"
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := fmt.Fprintln(h.w, line)
+	return err
+}
+
+// WithAttrs returns a handler that includes attrs on every record
+func (h *PrettyHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(append([]slog.Attr{}, h.attrs...), attrs...)
+	return &clone
+}
+
+// WithGroup flattens groups; the pretty format has no nesting
+func (h *PrettyHandler) WithGroup(string) slog.Handler {
+	return h
+}
@@ -0,0 +1,65 @@
+package logutil
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestPrettyHandlerRendersEmojiAndAttrs(t *testing.T) {
+	var buf strings.Builder
+	logger := slog.New(NewPrettyHandler(&buf))
+
+	logger.Info("goal completed", Emoji("✅"), "goal_id", "g1", "progress", 1.0)
+
+	line := strings.TrimSpace(buf.String())
+	if !strings.HasPrefix(line, "✅ goal completed") {
+		t.Errorf("emoji should prefix the message, got %q", line)
+	}
+	if !strings.Contains(line, "goal_id=g1") {
+		t.Errorf("attributes should render as key=value, got %q", line)
+	}
+	if strings.Contains(line, EmojiKey+"=") {
+		t.Errorf("emoji attribute should not repeat as key=value, got %q", line)
+	}
+}
+
+func TestPrettyHandlerOmitsEmptyDecoration(t *testing.T) {
+	var buf strings.Builder
+	logger := slog.New(NewPrettyHandler(&buf))
+
+	logger.Info("plain message")
+
+	if line := strings.TrimSpace(buf.String()); line != "plain message" {
+		t.Errorf("records without attrs should render bare, got %q", line)
+	}
+}
+
+func TestPrettyHandlerCarriesWithAttrs(t *testing.T) {
+	var buf strings.Builder
+	logger := slog.New(NewPrettyHandler(&buf)).With("subsystem", "goals")
+
+	logger.Info("tick")
+
+	if line := strings.TrimSpace(buf.String()); !strings.Contains(line, "subsystem=goals") {
+		t.Errorf("With attributes should appear on every record, got %q", line)
+	}
+}
+
+func TestSetLoggerReplacesAndRestoresDefault(t *testing.T) {
+	original := Logger()
+	t.Cleanup(func() { SetLogger(original) })
+
+	var buf strings.Builder
+	custom := slog.New(slog.NewTextHandler(&buf, nil))
+
+	SetLogger(custom)
+	if Logger() != custom {
+		t.Error("SetLogger should install the provided logger")
+	}
+
+	SetLogger(nil)
+	if Logger() == custom {
+		t.Error("SetLogger(nil) should restore the default logger")
+	}
+}
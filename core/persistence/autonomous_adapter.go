@@ -8,21 +8,29 @@ import (
 
 // AutonomousPersistence provides high-level persistence operations for the autonomous system
 type AutonomousPersistence struct {
-	store *SQLiteStore
+	store Store
 }
 
-// NewAutonomousPersistence creates a new persistence adapter
+// NewAutonomousPersistence creates a new persistence adapter backed by SQLite
 func NewAutonomousPersistence(dbPath string) (*AutonomousPersistence, error) {
 	store, err := NewSQLiteStore(dbPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create store: %w", err)
 	}
-	
+
 	return &AutonomousPersistence{
 		store: store,
 	}, nil
 }
 
+// NewAutonomousPersistenceWithStore creates a persistence adapter on top
+// of any Store implementation (e.g. PostgresStore)
+func NewAutonomousPersistenceWithStore(store Store) *AutonomousPersistence {
+	return &AutonomousPersistence{
+		store: store,
+	}
+}
+
 // Close closes the persistence layer
 func (ap *AutonomousPersistence) Close() error {
 	return ap.store.Close()
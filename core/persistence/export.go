@@ -0,0 +1,223 @@
+package persistence
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// exportVersion identifies the envelope format; bump when the shape of
+// the envelope changes
+const exportVersion = 1
+
+// exportEnvelope is the versioned container for a full store dump
+type exportEnvelope struct {
+	Version    int              `json:"version"`
+	ExportedAt time.Time        `json:"exported_at"`
+	Thoughts   []*ThoughtRecord `json:"thoughts"`
+	Memories   []*MemoryRecord  `json:"memories"`
+	State      []exportState    `json:"state"`
+	Goals      []*GoalRecord    `json:"goals"`
+}
+
+// exportState carries a raw state row, preserving its version for CAS
+type exportState struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Version int64  `json:"version"`
+}
+
+// ExportJSON serializes all thoughts, memories, state, and goals into a
+// versioned JSON envelope written to w
+func (s *SQLiteStore) ExportJSON(w io.Writer) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.isOpen {
+		return fmt.Errorf("database not open")
+	}
+
+	envelope := exportEnvelope{
+		Version:    exportVersion,
+		ExportedAt: time.Now(),
+		Thoughts:   make([]*ThoughtRecord, 0),
+		Memories:   make([]*MemoryRecord, 0),
+		State:      make([]exportState, 0),
+		Goals:      make([]*GoalRecord, 0),
+	}
+
+	rows, err := s.db.Query(`SELECT id, content, type, timestamp, context, interests, importance FROM thoughts ORDER BY id`)
+	if err != nil {
+		return fmt.Errorf("failed to query thoughts: %w", err)
+	}
+	for rows.Next() {
+		thought := &ThoughtRecord{}
+		if err := rows.Scan(&thought.ID, &thought.Content, &thought.Type, &thought.Timestamp, &thought.Context, &thought.Interests, &thought.Importance); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan thought: %w", err)
+		}
+		envelope.Thoughts = append(envelope.Thoughts, thought)
+	}
+	rows.Close()
+
+	rows, err = s.db.Query(`SELECT id, content, type, timestamp, strength, associations FROM memories ORDER BY id`)
+	if err != nil {
+		return fmt.Errorf("failed to query memories: %w", err)
+	}
+	for rows.Next() {
+		memory := &MemoryRecord{}
+		if err := rows.Scan(&memory.ID, &memory.Content, &memory.Type, &memory.Timestamp, &memory.Strength, &memory.Associations); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan memory: %w", err)
+		}
+		envelope.Memories = append(envelope.Memories, memory)
+	}
+	rows.Close()
+
+	rows, err = s.db.Query(`SELECT key, value, version FROM state ORDER BY key`)
+	if err != nil {
+		return fmt.Errorf("failed to query state: %w", err)
+	}
+	for rows.Next() {
+		var state exportState
+		if err := rows.Scan(&state.Key, &state.Value, &state.Version); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan state: %w", err)
+		}
+		envelope.State = append(envelope.State, state)
+	}
+	rows.Close()
+
+	rows, err = s.db.Query(`SELECT id, description, type, priority, status, created_at, completed_at, metadata FROM goals ORDER BY id`)
+	if err != nil {
+		return fmt.Errorf("failed to query goals: %w", err)
+	}
+	for rows.Next() {
+		goal := &GoalRecord{}
+		if err := rows.Scan(&goal.ID, &goal.Description, &goal.Type, &goal.Priority, &goal.Status, &goal.CreatedAt, &goal.CompletedAt, &goal.Metadata); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan goal: %w", err)
+		}
+		envelope.Goals = append(envelope.Goals, goal)
+	}
+	rows.Close()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(&envelope); err != nil {
+		return fmt.Errorf("failed to encode envelope: %w", err)
+	}
+
+	return nil
+}
+
+// ImportJSON restores an envelope produced by ExportJSON inside a single
+// transaction. State keys are upserted; goals already present (by
+// content hash) are skipped.
+func (s *SQLiteStore) ImportJSON(r io.Reader) error {
+	var envelope exportEnvelope
+	if err := json.NewDecoder(r).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode envelope: %w", err)
+	}
+
+	if envelope.Version != exportVersion {
+		return fmt.Errorf("unsupported export version %d (want %d)", envelope.Version, exportVersion)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isOpen {
+		return fmt.Errorf("database not open")
+	}
+
+	// Hash existing goals so duplicates in the envelope are skipped
+	existingGoals := make(map[string]bool)
+	rows, err := s.db.Query(`SELECT description, type, metadata FROM goals`)
+	if err != nil {
+		return fmt.Errorf("failed to query goals: %w", err)
+	}
+	for rows.Next() {
+		var description, goalType, metadata string
+		if err := rows.Scan(&description, &goalType, &metadata); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan goal: %w", err)
+		}
+		existingGoals[goalContentHash(description, goalType, metadata)] = true
+	}
+	rows.Close()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	for _, thought := range envelope.Thoughts {
+		_, err := tx.Exec(
+			`INSERT INTO thoughts (content, type, timestamp, context, interests, importance) VALUES (?, ?, ?, ?, ?, ?)`,
+			thought.Content, thought.Type, thought.Timestamp, thought.Context, thought.Interests, thought.Importance,
+		)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to import thought: %w", err)
+		}
+	}
+
+	for _, memory := range envelope.Memories {
+		_, err := tx.Exec(
+			`INSERT INTO memories (content, type, timestamp, strength, associations) VALUES (?, ?, ?, ?, ?)`,
+			memory.Content, memory.Type, memory.Timestamp, memory.Strength, memory.Associations,
+		)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to import memory: %w", err)
+		}
+	}
+
+	for _, state := range envelope.State {
+		_, err := tx.Exec(`
+			INSERT INTO state (key, value, version, updated_at)
+			VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+			ON CONFLICT(key) DO UPDATE SET
+				value = excluded.value,
+				version = excluded.version,
+				updated_at = CURRENT_TIMESTAMP
+		`, state.Key, state.Value, state.Version)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to import state key %s: %w", state.Key, err)
+		}
+	}
+
+	for _, goal := range envelope.Goals {
+		hash := goalContentHash(goal.Description, goal.Type, goal.Metadata)
+		if existingGoals[hash] {
+			continue
+		}
+		existingGoals[hash] = true
+
+		_, err := tx.Exec(
+			`INSERT INTO goals (description, type, priority, status, created_at, completed_at, metadata) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			goal.Description, goal.Type, goal.Priority, goal.Status, goal.CreatedAt, goal.CompletedAt, goal.Metadata,
+		)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to import goal: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit import: %w", err)
+	}
+
+	return nil
+}
+
+// goalContentHash identifies a goal by its content for duplicate
+// detection during import
+func goalContentHash(description, goalType, metadata string) string {
+	sum := sha256.Sum256([]byte(description + "\x00" + goalType + "\x00" + metadata))
+	return fmt.Sprintf("%x", sum)
+}
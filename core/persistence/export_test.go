@@ -0,0 +1,151 @@
+package persistence
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestExportImportRoundTrip(t *testing.T) {
+	source := newTestStore(t)
+
+	thought := &ThoughtRecord{
+		Content:    "exported thought",
+		Type:       "reflection",
+		Timestamp:  time.Now().Round(time.Second),
+		Context:    `["context"]`,
+		Importance: 0.8,
+	}
+	if err := source.SaveThought(thought); err != nil {
+		t.Fatalf("failed to save thought: %v", err)
+	}
+
+	memory := &MemoryRecord{
+		Content:   "exported memory",
+		Type:      "episodic",
+		Timestamp: time.Now().Round(time.Second),
+		Strength:  0.9,
+	}
+	if err := source.SaveMemory(memory); err != nil {
+		t.Fatalf("failed to save memory: %v", err)
+	}
+
+	if err := source.SaveState("identity", "echo"); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+
+	goal := &GoalRecord{
+		Description: "cultivate wisdom",
+		Type:        "wisdom",
+		Priority:    0.9,
+		Status:      "active",
+		CreatedAt:   time.Now().Round(time.Second),
+		Metadata:    `{"origin":"test"}`,
+	}
+	if err := source.SaveGoal(goal); err != nil {
+		t.Fatalf("failed to save goal: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := source.ExportJSON(&buf); err != nil {
+		t.Fatalf("ExportJSON failed: %v", err)
+	}
+
+	target := newTestStore(t)
+	if err := target.ImportJSON(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("ImportJSON failed: %v", err)
+	}
+
+	thoughts, err := target.GetRecentThoughts(10)
+	if err != nil {
+		t.Fatalf("failed to load thoughts: %v", err)
+	}
+	if len(thoughts) != 1 {
+		t.Fatalf("expected 1 thought after import, got %d", len(thoughts))
+	}
+	if thoughts[0].Content != thought.Content || thoughts[0].Importance != thought.Importance || thoughts[0].Context != thought.Context {
+		t.Errorf("thought fields not preserved: %+v", thoughts[0])
+	}
+
+	memories, err := target.GetStrongMemories(0.0, 10)
+	if err != nil {
+		t.Fatalf("failed to load memories: %v", err)
+	}
+	if len(memories) != 1 || memories[0].Content != memory.Content || memories[0].Strength != memory.Strength {
+		t.Errorf("memory not preserved, got %d memories", len(memories))
+	}
+
+	var identity string
+	if err := target.GetState("identity", &identity); err != nil {
+		t.Fatalf("failed to load state: %v", err)
+	}
+	if identity != "echo" {
+		t.Errorf("state not preserved, got %q", identity)
+	}
+
+	goals, err := target.GetActiveGoals()
+	if err != nil {
+		t.Fatalf("failed to load goals: %v", err)
+	}
+	if len(goals) != 1 || goals[0].Description != goal.Description {
+		t.Errorf("goal not preserved, got %d goals", len(goals))
+	}
+}
+
+func TestImportSkipsDuplicateGoalsAndUpsertsState(t *testing.T) {
+	source := newTestStore(t)
+
+	goal := &GoalRecord{
+		Description: "cultivate wisdom",
+		Type:        "wisdom",
+		Priority:    0.9,
+		Status:      "active",
+		CreatedAt:   time.Now().Round(time.Second),
+	}
+	if err := source.SaveGoal(goal); err != nil {
+		t.Fatalf("failed to save goal: %v", err)
+	}
+	if err := source.SaveState("identity", "exported"); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := source.ExportJSON(&buf); err != nil {
+		t.Fatalf("ExportJSON failed: %v", err)
+	}
+
+	// Target already holds the same goal and a conflicting state value
+	target := newTestStore(t)
+	if err := target.SaveGoal(&GoalRecord{
+		Description: goal.Description,
+		Type:        goal.Type,
+		Priority:    0.5,
+		Status:      "active",
+		CreatedAt:   time.Now(),
+	}); err != nil {
+		t.Fatalf("failed to save goal: %v", err)
+	}
+	if err := target.SaveState("identity", "stale"); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+
+	if err := target.ImportJSON(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("ImportJSON failed: %v", err)
+	}
+
+	goals, err := target.GetActiveGoals()
+	if err != nil {
+		t.Fatalf("failed to load goals: %v", err)
+	}
+	if len(goals) != 1 {
+		t.Errorf("duplicate goal should be skipped, got %d goals", len(goals))
+	}
+
+	var identity string
+	if err := target.GetState("identity", &identity); err != nil {
+		t.Fatalf("failed to load state: %v", err)
+	}
+	if identity != "exported" {
+		t.Errorf("state key should be upserted to the imported value, got %q", identity)
+	}
+}
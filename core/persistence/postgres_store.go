@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	_ "github.com/lib/pq"
+)
+
+// ErrNotImplemented is returned by PostgresStore methods that have not
+// been ported from the SQLite implementation yet
+var ErrNotImplemented = errors.New("postgres store: not implemented")
+
+// PostgresStore is a skeleton Store implementation backed by Postgres.
+// The connection lifecycle works; the data methods are stubs to be
+// filled in as production deployments need them.
+type PostgresStore struct {
+	db  *sql.DB
+	dsn string
+}
+
+// Compile-time check that PostgresStore satisfies the Store contract
+var _ Store = (*PostgresStore)(nil)
+
+// NewPostgresStore creates a store connected to the given Postgres DSN
+func NewPostgresStore(dsn string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresStore{db: db, dsn: dsn}, nil
+}
+
+// Close closes the database connection
+func (p *PostgresStore) Close() error {
+	if p.db == nil {
+		return nil
+	}
+	return p.db.Close()
+}
+
+// SaveThought persists a thought to the database
+func (p *PostgresStore) SaveThought(thought *ThoughtRecord) error {
+	return ErrNotImplemented
+}
+
+// GetRecentThoughts retrieves the most recent thoughts
+func (p *PostgresStore) GetRecentThoughts(limit int) ([]*ThoughtRecord, error) {
+	return nil, ErrNotImplemented
+}
+
+// SaveMemory persists a memory to the database
+func (p *PostgresStore) SaveMemory(memory *MemoryRecord) error {
+	return ErrNotImplemented
+}
+
+// GetStrongMemories retrieves memories above a strength threshold
+func (p *PostgresStore) GetStrongMemories(minStrength float64, limit int) ([]*MemoryRecord, error) {
+	return nil, ErrNotImplemented
+}
+
+// SaveState persists a key-value state
+func (p *PostgresStore) SaveState(key string, value interface{}) error {
+	return ErrNotImplemented
+}
+
+// GetState retrieves a state value by key
+func (p *PostgresStore) GetState(key string, target interface{}) error {
+	return ErrNotImplemented
+}
+
+// SaveGoal persists a goal to the database
+func (p *PostgresStore) SaveGoal(goal *GoalRecord) error {
+	return ErrNotImplemented
+}
+
+// GetActiveGoals retrieves all active goals
+func (p *PostgresStore) GetActiveGoals() ([]*GoalRecord, error) {
+	return nil, ErrNotImplemented
+}
+
+// UpdateGoalStatus updates a goal's status
+func (p *PostgresStore) UpdateGoalStatus(goalID int64, status string) error {
+	return ErrNotImplemented
+}
+
+// GetStats returns database statistics
+func (p *PostgresStore) GetStats() (map[string]interface{}, error) {
+	return nil, ErrNotImplemented
+}
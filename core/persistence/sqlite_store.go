@@ -3,6 +3,7 @@ package persistence
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -10,12 +11,75 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// ErrStateConflict is returned by SaveStateCAS when the stored version no
+// longer matches the caller's expected version
+var ErrStateConflict = errors.New("state version conflict")
+
 // SQLiteStore provides persistent storage for the autonomous system
 type SQLiteStore struct {
-	mu       sync.RWMutex
-	db       *sql.DB
-	dbPath   string
-	isOpen   bool
+	mu            sync.RWMutex
+	db            *sql.DB
+	dbPath        string
+	isOpen        bool
+	options       StoreOptions
+	retention     RetentionPolicy
+	ftsEnabled    bool
+	retentionStop chan struct{}
+}
+
+// StoreOptions configures the SQLite connection's journaling and locking
+// behavior. The defaults favor concurrent writers from multiple
+// autonomous loops.
+type StoreOptions struct {
+	// JournalMode is applied as PRAGMA journal_mode (e.g. "WAL")
+	JournalMode string
+	// Synchronous is applied as PRAGMA synchronous (e.g. "NORMAL")
+	Synchronous string
+	// BusyTimeout is how long a locked write waits before failing
+	BusyTimeout time.Duration
+}
+
+// DefaultStoreOptions returns the standard connection options
+func DefaultStoreOptions() StoreOptions {
+	return StoreOptions{
+		JournalMode: "WAL",
+		Synchronous: "NORMAL",
+		BusyTimeout: 5 * time.Second,
+	}
+}
+
+// RetentionPolicy controls which memories are exempt from decay-based
+// pruning. Memories tagged with an exempt type, or whose strength is at
+// or above the importance floor, are retained regardless of age.
+type RetentionPolicy struct {
+	// ImportanceFloor exempts memories at or above this strength
+	ImportanceFloor float64
+	// ExemptTypes lists memory types that are never pruned (e.g. "milestone")
+	ExemptTypes []string
+	// MaxThoughtAge is how long low-importance thoughts are kept by the
+	// background retention loop
+	MaxThoughtAge time.Duration
+	// MinThoughtImportance protects thoughts at or above this importance
+	// from age-based pruning
+	MinThoughtImportance float64
+	// MaxMemoryAge is how long weak memories are kept by the background
+	// retention loop
+	MaxMemoryAge time.Duration
+	// MinMemoryStrength protects memories at or above this strength from
+	// age-based pruning
+	MinMemoryStrength float64
+}
+
+// DefaultRetentionPolicy returns the standard retention policy
+func DefaultRetentionPolicy() RetentionPolicy {
+	return RetentionPolicy{
+		ImportanceFloor:      0.8,
+		ExemptTypes:          []string{"milestone"},
+		MaxThoughtAge:        30 * 24 * time.Hour,
+		MinThoughtImportance: 0.7,
+		MaxMemoryAge:         90 * 24 * time.Hour,
+		MinMemoryStrength:    0.5,
+	}
 }
 
 // ThoughtRecord represents a persisted thought
@@ -60,9 +124,16 @@ type GoalRecord struct {
 }
 
 // NewSQLiteStore creates a new SQLite-based persistence store
-func NewSQLiteStore(dbPath string) (*SQLiteStore, error) {
+func NewSQLiteStore(dbPath string, opts ...StoreOptions) (*SQLiteStore, error) {
+	options := DefaultStoreOptions()
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
 	store := &SQLiteStore{
-		dbPath: dbPath,
+		dbPath:    dbPath,
+		options:   options,
+		retention: DefaultRetentionPolicy(),
 	}
 	
 	if err := store.Open(); err != nil {
@@ -98,10 +169,27 @@ func (s *SQLiteStore) Open() error {
 	if err := db.Ping(); err != nil {
 		return fmt.Errorf("failed to ping database: %w", err)
 	}
-	
+
+	// Apply journaling and locking options before any writes
+	if s.options.JournalMode != "" {
+		if _, err := db.Exec("PRAGMA journal_mode=" + s.options.JournalMode); err != nil {
+			return fmt.Errorf("failed to set journal mode: %w", err)
+		}
+	}
+	if s.options.Synchronous != "" {
+		if _, err := db.Exec("PRAGMA synchronous=" + s.options.Synchronous); err != nil {
+			return fmt.Errorf("failed to set synchronous mode: %w", err)
+		}
+	}
+	if s.options.BusyTimeout > 0 {
+		if _, err := db.Exec(fmt.Sprintf("PRAGMA busy_timeout=%d", s.options.BusyTimeout.Milliseconds())); err != nil {
+			return fmt.Errorf("failed to set busy timeout: %w", err)
+		}
+	}
+
 	s.db = db
 	s.isOpen = true
-	
+
 	return nil
 }
 
@@ -109,11 +197,16 @@ func (s *SQLiteStore) Open() error {
 func (s *SQLiteStore) Close() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	
+
 	if !s.isOpen || s.db == nil {
 		return nil
 	}
-	
+
+	if s.retentionStop != nil {
+		close(s.retentionStop)
+		s.retentionStop = nil
+	}
+
 	err := s.db.Close()
 	s.isOpen = false
 	return err
@@ -147,6 +240,7 @@ func (s *SQLiteStore) initSchema() error {
 		id INTEGER PRIMARY KEY AUTOINCREMENT,
 		key TEXT UNIQUE NOT NULL,
 		value TEXT NOT NULL,
+		version INTEGER NOT NULL DEFAULT 0,
 		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);
 
@@ -169,8 +263,38 @@ func (s *SQLiteStore) initSchema() error {
 	CREATE INDEX IF NOT EXISTS idx_goals_priority ON goals(priority DESC);
 	`
 	
-	_, err := s.db.Exec(schema)
-	return err
+	if _, err := s.db.Exec(schema); err != nil {
+		return err
+	}
+
+	// Databases created before versioned state lack the column; adding it
+	// again on a current schema fails harmlessly
+	s.db.Exec(`ALTER TABLE state ADD COLUMN version INTEGER NOT NULL DEFAULT 0`)
+
+	// Full-text search over thoughts is optional: FTS5 depends on how the
+	// sqlite3 driver was compiled, so a failure here just disables
+	// SearchThoughts ranking and we fall back to a LIKE scan
+	ftsSchema := `
+	CREATE VIRTUAL TABLE IF NOT EXISTS thoughts_fts USING fts5(
+		content,
+		content='thoughts',
+		content_rowid='id'
+	);
+
+	CREATE TRIGGER IF NOT EXISTS thoughts_fts_insert AFTER INSERT ON thoughts BEGIN
+		INSERT INTO thoughts_fts(rowid, content) VALUES (new.id, new.content);
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS thoughts_fts_delete AFTER DELETE ON thoughts BEGIN
+		INSERT INTO thoughts_fts(thoughts_fts, rowid, content) VALUES ('delete', old.id, old.content);
+	END;
+	`
+
+	if _, err := s.db.Exec(ftsSchema); err == nil {
+		s.ftsEnabled = true
+	}
+
+	return nil
 }
 
 // SaveThought persists a thought to the database
@@ -208,6 +332,62 @@ func (s *SQLiteStore) SaveThought(thought *ThoughtRecord) error {
 	return nil
 }
 
+// SaveThoughts persists a batch of thoughts in a single transaction,
+// reusing one prepared statement. Each record's ID is populated from the
+// insert. The whole batch is rolled back on any error.
+func (s *SQLiteStore) SaveThoughts(thoughts []*ThoughtRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isOpen {
+		return fmt.Errorf("database not open")
+	}
+
+	if len(thoughts) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO thoughts (content, type, timestamp, context, interests, importance)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, thought := range thoughts {
+		result, err := stmt.Exec(
+			thought.Content,
+			thought.Type,
+			thought.Timestamp,
+			thought.Context,
+			thought.Interests,
+			thought.Importance,
+		)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to save thought batch: %w", err)
+		}
+
+		if id, err := result.LastInsertId(); err == nil {
+			thought.ID = id
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit thought batch: %w", err)
+	}
+
+	return nil
+}
+
 // GetRecentThoughts retrieves the most recent thoughts
 func (s *SQLiteStore) GetRecentThoughts(limit int) ([]*ThoughtRecord, error) {
 	s.mu.RLock()
@@ -247,7 +427,163 @@ func (s *SQLiteStore) GetRecentThoughts(limit int) ([]*ThoughtRecord, error) {
 		}
 		thoughts = append(thoughts, thought)
 	}
-	
+
+	return thoughts, nil
+}
+
+// GetThoughtsBetween retrieves thoughts with timestamps in [start, end),
+// ordered by timestamp ascending or descending
+func (s *SQLiteStore) GetThoughtsBetween(start, end time.Time, limit int, ascending bool) ([]*ThoughtRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.isOpen {
+		return nil, fmt.Errorf("database not open")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, content, type, timestamp, context, interests, importance
+		FROM thoughts
+		WHERE timestamp >= ? AND timestamp < ?
+		ORDER BY timestamp %s
+		LIMIT ?
+	`, sortDirection(ascending))
+
+	rows, err := s.db.Query(query, start, end, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query thoughts: %w", err)
+	}
+	defer rows.Close()
+
+	thoughts := make([]*ThoughtRecord, 0, limit)
+	for rows.Next() {
+		thought := &ThoughtRecord{}
+		err := rows.Scan(
+			&thought.ID,
+			&thought.Content,
+			&thought.Type,
+			&thought.Timestamp,
+			&thought.Context,
+			&thought.Interests,
+			&thought.Importance,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan thought: %w", err)
+		}
+		thoughts = append(thoughts, thought)
+	}
+
+	return thoughts, nil
+}
+
+// GetMemoriesBetween retrieves memories with timestamps in [start, end),
+// ordered by timestamp ascending or descending
+func (s *SQLiteStore) GetMemoriesBetween(start, end time.Time, limit int, ascending bool) ([]*MemoryRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.isOpen {
+		return nil, fmt.Errorf("database not open")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, content, type, timestamp, strength, associations
+		FROM memories
+		WHERE timestamp >= ? AND timestamp < ?
+		ORDER BY timestamp %s
+		LIMIT ?
+	`, sortDirection(ascending))
+
+	rows, err := s.db.Query(query, start, end, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query memories: %w", err)
+	}
+	defer rows.Close()
+
+	memories := make([]*MemoryRecord, 0, limit)
+	for rows.Next() {
+		memory := &MemoryRecord{}
+		err := rows.Scan(
+			&memory.ID,
+			&memory.Content,
+			&memory.Type,
+			&memory.Timestamp,
+			&memory.Strength,
+			&memory.Associations,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan memory: %w", err)
+		}
+		memories = append(memories, memory)
+	}
+
+	return memories, nil
+}
+
+// sortDirection maps an ascending flag to a SQL ORDER BY direction
+func sortDirection(ascending bool) string {
+	if ascending {
+		return "ASC"
+	}
+	return "DESC"
+}
+
+// SearchThoughts retrieves thoughts whose content matches the query,
+// ranked by relevance when FTS5 is available. Without FTS5 it falls back
+// to a LIKE scan ordered by recency.
+func (s *SQLiteStore) SearchThoughts(query string, limit int) ([]*ThoughtRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.isOpen {
+		return nil, fmt.Errorf("database not open")
+	}
+
+	var rows *sql.Rows
+	var err error
+
+	if s.ftsEnabled {
+		rows, err = s.db.Query(`
+			SELECT t.id, t.content, t.type, t.timestamp, t.context, t.interests, t.importance
+			FROM thoughts_fts f
+			JOIN thoughts t ON t.id = f.rowid
+			WHERE thoughts_fts MATCH ?
+			ORDER BY rank
+			LIMIT ?
+		`, query, limit)
+	} else {
+		rows, err = s.db.Query(`
+			SELECT id, content, type, timestamp, context, interests, importance
+			FROM thoughts
+			WHERE content LIKE '%' || ? || '%'
+			ORDER BY timestamp DESC
+			LIMIT ?
+		`, query, limit)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to search thoughts: %w", err)
+	}
+	defer rows.Close()
+
+	thoughts := make([]*ThoughtRecord, 0, limit)
+	for rows.Next() {
+		thought := &ThoughtRecord{}
+		err := rows.Scan(
+			&thought.ID,
+			&thought.Content,
+			&thought.Type,
+			&thought.Timestamp,
+			&thought.Context,
+			&thought.Interests,
+			&thought.Importance,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan thought: %w", err)
+		}
+		thoughts = append(thoughts, thought)
+	}
+
 	return thoughts, nil
 }
 
@@ -328,6 +664,211 @@ func (s *SQLiteStore) GetStrongMemories(minStrength float64, limit int) ([]*Memo
 	return memories, nil
 }
 
+// SetRetentionPolicy replaces the store's memory retention policy
+func (s *SQLiteStore) SetRetentionPolicy(policy RetentionPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retention = policy
+}
+
+// GetRetentionPolicy returns the store's current memory retention policy
+func (s *SQLiteStore) GetRetentionPolicy() RetentionPolicy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.retention
+}
+
+// retentionClause builds a SQL predicate (and its arguments) that matches
+// only prunable memories under the current policy. Callers must hold the
+// lock.
+func (s *SQLiteStore) retentionClause() (string, []interface{}) {
+	clause := "strength < ?"
+	args := []interface{}{s.retention.ImportanceFloor}
+
+	for range s.retention.ExemptTypes {
+		clause += " AND type != ?"
+	}
+	for _, t := range s.retention.ExemptTypes {
+		args = append(args, t)
+	}
+
+	return clause, args
+}
+
+// DecayMemories multiplies the strength of non-exempt memories by factor,
+// modeling gradual forgetting. Exempt memories keep their strength.
+func (s *SQLiteStore) DecayMemories(factor float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isOpen {
+		return fmt.Errorf("database not open")
+	}
+
+	clause, args := s.retentionClause()
+	query := fmt.Sprintf("UPDATE memories SET strength = strength * ? WHERE %s", clause)
+
+	_, err := s.db.Exec(query, append([]interface{}{factor}, args...)...)
+	if err != nil {
+		return fmt.Errorf("failed to decay memories: %w", err)
+	}
+
+	return nil
+}
+
+// PruneWeakMemories deletes memories whose strength has decayed below the
+// threshold, except those protected by the retention policy. Returns the
+// number of memories removed.
+func (s *SQLiteStore) PruneWeakMemories(threshold float64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isOpen {
+		return 0, fmt.Errorf("database not open")
+	}
+
+	clause, args := s.retentionClause()
+	query := fmt.Sprintf("DELETE FROM memories WHERE strength < ? AND %s", clause)
+
+	result, err := s.db.Exec(query, append([]interface{}{threshold}, args...)...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune memories: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, nil
+	}
+
+	return int(deleted), nil
+}
+
+// PruneThoughts deletes thoughts older than the cutoff, keeping any whose
+// importance is at or above keepMinImportance. Returns the number deleted.
+func (s *SQLiteStore) PruneThoughts(olderThan time.Time, keepMinImportance float64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isOpen {
+		return 0, fmt.Errorf("database not open")
+	}
+
+	return s.pruneInTx(
+		"DELETE FROM thoughts WHERE timestamp < ? AND importance < ?",
+		olderThan, keepMinImportance,
+	)
+}
+
+// PruneMemories deletes memories older than the cutoff, keeping any whose
+// strength is at or above keepMinStrength or whose type is exempt under
+// the retention policy. Returns the number deleted.
+func (s *SQLiteStore) PruneMemories(olderThan time.Time, keepMinStrength float64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isOpen {
+		return 0, fmt.Errorf("database not open")
+	}
+
+	query := "DELETE FROM memories WHERE timestamp < ? AND strength < ?"
+	args := []interface{}{olderThan, keepMinStrength}
+	for _, t := range s.retention.ExemptTypes {
+		query += " AND type != ?"
+		args = append(args, t)
+	}
+
+	return s.pruneInTx(query, args...)
+}
+
+// pruneInTx runs a delete statement inside a transaction and reports the
+// number of rows removed. Callers must hold the lock.
+func (s *SQLiteStore) pruneInTx(query string, args ...interface{}) (int, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("failed to prune: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		deleted = 0
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit prune: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// StartRetention runs age-based pruning on the given interval until the
+// store is closed or StopRetention is called. Every tenth pass issues a
+// VACUUM to return freed pages to the filesystem.
+func (s *SQLiteStore) StartRetention(interval time.Duration, policy RetentionPolicy) {
+	s.mu.Lock()
+	if s.retentionStop != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.retention = policy
+	stop := make(chan struct{})
+	s.retentionStop = stop
+	s.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		passes := 0
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				s.retentionPass()
+				passes++
+				if passes%10 == 0 {
+					s.mu.Lock()
+					if s.isOpen {
+						s.db.Exec("VACUUM")
+					}
+					s.mu.Unlock()
+				}
+			}
+		}
+	}()
+}
+
+// StopRetention halts the background retention loop if it is running
+func (s *SQLiteStore) StopRetention() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.retentionStop != nil {
+		close(s.retentionStop)
+		s.retentionStop = nil
+	}
+}
+
+// retentionPass applies the retention policy's age cutoffs once
+func (s *SQLiteStore) retentionPass() {
+	s.mu.RLock()
+	policy := s.retention
+	s.mu.RUnlock()
+
+	if policy.MaxThoughtAge > 0 {
+		s.PruneThoughts(time.Now().Add(-policy.MaxThoughtAge), policy.MinThoughtImportance)
+	}
+	if policy.MaxMemoryAge > 0 {
+		s.PruneMemories(time.Now().Add(-policy.MaxMemoryAge), policy.MinMemoryStrength)
+	}
+}
+
 // SaveState persists a key-value state
 func (s *SQLiteStore) SaveState(key string, value interface{}) error {
 	s.mu.Lock()
@@ -343,19 +884,105 @@ func (s *SQLiteStore) SaveState(key string, value interface{}) error {
 		return fmt.Errorf("failed to marshal value: %w", err)
 	}
 	
+	// Upsert rather than INSERT OR REPLACE: REPLACE deletes and
+	// reinserts the row, which would reset the version column to its
+	// default and break SaveStateCAS. Every write bumps the shared
+	// version so CAS callers see plain saves as conflicts.
 	query := `
-		INSERT OR REPLACE INTO state (key, value, updated_at)
-		VALUES (?, ?, CURRENT_TIMESTAMP)
+		INSERT INTO state (key, value, version, updated_at)
+		VALUES (?, ?, 1, CURRENT_TIMESTAMP)
+		ON CONFLICT(key) DO UPDATE SET
+			value = excluded.value,
+			version = version + 1,
+			updated_at = CURRENT_TIMESTAMP
 	`
-	
+
 	_, err = s.db.Exec(query, key, string(valueJSON))
 	if err != nil {
 		return fmt.Errorf("failed to save state: %w", err)
 	}
-	
+
 	return nil
 }
 
+// SaveStateCAS saves a state value only if the stored version matches
+// expectedVersion, incrementing the version on success. A key that does
+// not exist yet has version 0. Returns ErrStateConflict on a stale write.
+func (s *SQLiteStore) SaveStateCAS(key string, expectedVersion int64, value interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isOpen {
+		return fmt.Errorf("database not open")
+	}
+
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal value: %w", err)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	var current int64
+	err = tx.QueryRow(`SELECT version FROM state WHERE key = ?`, key).Scan(&current)
+	if err == sql.ErrNoRows {
+		current = 0
+	} else if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to query state version: %w", err)
+	}
+
+	if current != expectedVersion {
+		tx.Rollback()
+		return fmt.Errorf("key %s at version %d, expected %d: %w", key, current, expectedVersion, ErrStateConflict)
+	}
+
+	query := `
+		INSERT INTO state (key, value, version, updated_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(key) DO UPDATE SET
+			value = excluded.value,
+			version = excluded.version,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	if _, err := tx.Exec(query, key, string(valueJSON), expectedVersion+1); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit state: %w", err)
+	}
+
+	return nil
+}
+
+// GetStateVersion returns the current version of a state key, with 0 for
+// keys that have never been written
+func (s *SQLiteStore) GetStateVersion(key string) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.isOpen {
+		return 0, fmt.Errorf("database not open")
+	}
+
+	var version int64
+	err := s.db.QueryRow(`SELECT version FROM state WHERE key = ?`, key).Scan(&version)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to query state version: %w", err)
+	}
+
+	return version, nil
+}
+
 // GetState retrieves a state value by key
 func (s *SQLiteStore) GetState(key string, target interface{}) error {
 	s.mu.RLock()
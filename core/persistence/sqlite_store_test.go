@@ -0,0 +1,515 @@
+package persistence
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T) *SQLiteStore {
+	t.Helper()
+
+	store, err := NewSQLiteStore(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	return store
+}
+
+func TestSaveThoughtsBatch(t *testing.T) {
+	store := newTestStore(t)
+
+	thoughts := make([]*ThoughtRecord, 25)
+	for i := range thoughts {
+		thoughts[i] = &ThoughtRecord{
+			Content:    "batched thought",
+			Type:       "reflection",
+			Timestamp:  time.Now(),
+			Importance: 0.5,
+		}
+	}
+
+	if err := store.SaveThoughts(thoughts); err != nil {
+		t.Fatalf("SaveThoughts failed: %v", err)
+	}
+
+	for i, thought := range thoughts {
+		if thought.ID == 0 {
+			t.Errorf("thought %d did not get an ID", i)
+		}
+	}
+
+	recent, err := store.GetRecentThoughts(50)
+	if err != nil {
+		t.Fatalf("failed to load thoughts: %v", err)
+	}
+	if len(recent) != len(thoughts) {
+		t.Errorf("expected %d persisted thoughts, got %d", len(thoughts), len(recent))
+	}
+}
+
+func TestOpenAppliesStoreOptions(t *testing.T) {
+	store := newTestStore(t)
+
+	var mode string
+	if err := store.db.QueryRow("PRAGMA journal_mode").Scan(&mode); err != nil {
+		t.Fatalf("failed to query journal mode: %v", err)
+	}
+	if mode != "wal" {
+		t.Errorf("expected WAL journal mode by default, got %q", mode)
+	}
+}
+
+func TestConcurrentThoughtWrites(t *testing.T) {
+	store := newTestStore(t)
+
+	const perWriter = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*perWriter)
+
+	for w := 0; w < 2; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				err := store.SaveThought(&ThoughtRecord{
+					Content:    "concurrent thought",
+					Type:       "reflection",
+					Timestamp:  time.Now(),
+					Importance: 0.5,
+				})
+				if err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("concurrent write failed: %v", err)
+	}
+
+	recent, err := store.GetRecentThoughts(2 * perWriter)
+	if err != nil {
+		t.Fatalf("failed to load thoughts: %v", err)
+	}
+	if len(recent) != 2*perWriter {
+		t.Errorf("expected %d persisted thoughts, got %d", 2*perWriter, len(recent))
+	}
+}
+
+func TestGetThoughtsBetween(t *testing.T) {
+	store := newTestStore(t)
+
+	base := time.Now().Add(-3 * time.Hour)
+	thoughts := []*ThoughtRecord{
+		{Content: "first", Type: "reflection", Timestamp: base, Importance: 0.5},
+		{Content: "second", Type: "reflection", Timestamp: base.Add(time.Hour), Importance: 0.5},
+		{Content: "outside", Type: "reflection", Timestamp: base.Add(4 * time.Hour), Importance: 0.5},
+	}
+	if err := store.SaveThoughts(thoughts); err != nil {
+		t.Fatalf("SaveThoughts failed: %v", err)
+	}
+
+	results, err := store.GetThoughtsBetween(base.Add(-time.Minute), base.Add(2*time.Hour), 10, true)
+	if err != nil {
+		t.Fatalf("GetThoughtsBetween failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 thoughts in range, got %d", len(results))
+	}
+	if results[0].Content != "first" || results[1].Content != "second" {
+		t.Errorf("ascending order wrong: %q, %q", results[0].Content, results[1].Content)
+	}
+
+	results, err = store.GetThoughtsBetween(base.Add(-time.Minute), base.Add(2*time.Hour), 10, false)
+	if err != nil {
+		t.Fatalf("GetThoughtsBetween failed: %v", err)
+	}
+	if results[0].Content != "second" {
+		t.Errorf("descending order should start with newest, got %q", results[0].Content)
+	}
+}
+
+func TestGetMemoriesBetween(t *testing.T) {
+	store := newTestStore(t)
+
+	base := time.Now().Add(-3 * time.Hour)
+	memories := []*MemoryRecord{
+		{Content: "early memory", Type: "episodic", Timestamp: base, Strength: 0.5},
+		{Content: "late memory", Type: "episodic", Timestamp: base.Add(4 * time.Hour), Strength: 0.5},
+	}
+	for _, memory := range memories {
+		if err := store.SaveMemory(memory); err != nil {
+			t.Fatalf("failed to save memory: %v", err)
+		}
+	}
+
+	results, err := store.GetMemoriesBetween(base.Add(-time.Minute), base.Add(time.Hour), 10, true)
+	if err != nil {
+		t.Fatalf("GetMemoriesBetween failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Content != "early memory" {
+		t.Errorf("expected only the early memory in range, got %d results", len(results))
+	}
+}
+
+func TestSearchThoughts(t *testing.T) {
+	store := newTestStore(t)
+
+	thoughts := []*ThoughtRecord{
+		{Content: "pondering the nature of recursion", Type: "reflection", Timestamp: time.Now(), Importance: 0.5},
+		{Content: "recursion appears in tree structures", Type: "observation", Timestamp: time.Now(), Importance: 0.5},
+		{Content: "the weather is pleasant today", Type: "observation", Timestamp: time.Now(), Importance: 0.5},
+	}
+	if err := store.SaveThoughts(thoughts); err != nil {
+		t.Fatalf("SaveThoughts failed: %v", err)
+	}
+
+	results, err := store.SearchThoughts("recursion", 10)
+	if err != nil {
+		t.Fatalf("SearchThoughts failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 matching thoughts, got %d", len(results))
+	}
+	for _, thought := range results {
+		if thought.Content == "the weather is pleasant today" {
+			t.Error("non-matching thought returned from search")
+		}
+	}
+
+	// Search should respect deletions regardless of which path served it
+	if _, err := store.db.Exec("DELETE FROM thoughts WHERE type = 'reflection'"); err != nil {
+		t.Fatalf("failed to delete thought: %v", err)
+	}
+	results, err = store.SearchThoughts("recursion", 10)
+	if err != nil {
+		t.Fatalf("SearchThoughts failed after delete: %v", err)
+	}
+	if len(results) != 1 {
+		t.Errorf("expected 1 matching thought after delete, got %d", len(results))
+	}
+}
+
+func benchmarkThoughts(n int) []*ThoughtRecord {
+	thoughts := make([]*ThoughtRecord, n)
+	for i := range thoughts {
+		thoughts[i] = &ThoughtRecord{
+			Content:    "benchmark thought with a reasonably typical content length",
+			Type:       "reflection",
+			Timestamp:  time.Now(),
+			Importance: 0.5,
+		}
+	}
+	return thoughts
+}
+
+func BenchmarkSaveThoughtLoop(b *testing.B) {
+	store, err := NewSQLiteStore(filepath.Join(b.TempDir(), "bench.db"))
+	if err != nil {
+		b.Fatalf("failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, thought := range benchmarkThoughts(1000) {
+			if err := store.SaveThought(thought); err != nil {
+				b.Fatalf("SaveThought failed: %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkSaveThoughtsBatch(b *testing.B) {
+	store, err := NewSQLiteStore(filepath.Join(b.TempDir(), "bench.db"))
+	if err != nil {
+		b.Fatalf("failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := store.SaveThoughts(benchmarkThoughts(1000)); err != nil {
+			b.Fatalf("SaveThoughts failed: %v", err)
+		}
+	}
+}
+
+func TestPruneThoughtsKeepsImportant(t *testing.T) {
+	store := newTestStore(t)
+
+	old := time.Now().Add(-60 * 24 * time.Hour)
+	thoughts := []*ThoughtRecord{
+		{Content: "fleeting old thought", Type: "reflection", Timestamp: old, Importance: 0.3},
+		{Content: "formative old insight", Type: "reflection", Timestamp: old, Importance: 0.9},
+		{Content: "recent thought", Type: "reflection", Timestamp: time.Now(), Importance: 0.3},
+	}
+	if err := store.SaveThoughts(thoughts); err != nil {
+		t.Fatalf("SaveThoughts failed: %v", err)
+	}
+
+	deleted, err := store.PruneThoughts(time.Now().Add(-30*24*time.Hour), 0.7)
+	if err != nil {
+		t.Fatalf("PruneThoughts failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("expected 1 thought pruned, got %d", deleted)
+	}
+
+	remaining, err := store.GetRecentThoughts(10)
+	if err != nil {
+		t.Fatalf("failed to load thoughts: %v", err)
+	}
+	for _, thought := range remaining {
+		if thought.Content == "fleeting old thought" {
+			t.Error("old low-importance thought should have been pruned")
+		}
+	}
+	if len(remaining) != 2 {
+		t.Errorf("expected 2 surviving thoughts, got %d", len(remaining))
+	}
+}
+
+func TestPruneMemoriesRespectsExemptTypes(t *testing.T) {
+	store := newTestStore(t)
+
+	old := time.Now().Add(-120 * 24 * time.Hour)
+	memories := []*MemoryRecord{
+		{Content: "weak old memory", Type: "episodic", Timestamp: old, Strength: 0.2},
+		{Content: "weak old milestone", Type: "milestone", Timestamp: old, Strength: 0.2},
+	}
+	for _, memory := range memories {
+		if err := store.SaveMemory(memory); err != nil {
+			t.Fatalf("failed to save memory: %v", err)
+		}
+	}
+
+	deleted, err := store.PruneMemories(time.Now().Add(-90*24*time.Hour), 0.5)
+	if err != nil {
+		t.Fatalf("PruneMemories failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("expected 1 memory pruned, got %d", deleted)
+	}
+
+	remaining, err := store.GetStrongMemories(0.0, 10)
+	if err != nil {
+		t.Fatalf("failed to load memories: %v", err)
+	}
+	if len(remaining) != 1 || remaining[0].Type != "milestone" {
+		t.Errorf("only the milestone should survive, got %d memories", len(remaining))
+	}
+}
+
+func TestStartRetentionPrunesInBackground(t *testing.T) {
+	store := newTestStore(t)
+
+	old := &ThoughtRecord{
+		Content:    "stale background thought",
+		Type:       "reflection",
+		Timestamp:  time.Now().Add(-60 * 24 * time.Hour),
+		Importance: 0.1,
+	}
+	if err := store.SaveThought(old); err != nil {
+		t.Fatalf("failed to save thought: %v", err)
+	}
+
+	policy := DefaultRetentionPolicy()
+	store.StartRetention(10*time.Millisecond, policy)
+	defer store.StopRetention()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		remaining, err := store.GetRecentThoughts(10)
+		if err != nil {
+			t.Fatalf("failed to load thoughts: %v", err)
+		}
+		if len(remaining) == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Error("background retention did not prune the stale thought")
+}
+
+func TestSaveStateCASRejectsStaleWrite(t *testing.T) {
+	store := newTestStore(t)
+
+	if err := store.SaveStateCAS("identity", 0, "first"); err != nil {
+		t.Fatalf("initial CAS write failed: %v", err)
+	}
+
+	version, err := store.GetStateVersion("identity")
+	if err != nil {
+		t.Fatalf("failed to read version: %v", err)
+	}
+	if version != 1 {
+		t.Fatalf("expected version 1 after first write, got %d", version)
+	}
+
+	// Two writers both read version 1; the second write is stale
+	if err := store.SaveStateCAS("identity", 1, "second"); err != nil {
+		t.Fatalf("CAS write at current version failed: %v", err)
+	}
+	err = store.SaveStateCAS("identity", 1, "stale")
+	if !errors.Is(err, ErrStateConflict) {
+		t.Fatalf("expected ErrStateConflict for stale write, got %v", err)
+	}
+
+	var value string
+	if err := store.GetState("identity", &value); err != nil {
+		t.Fatalf("failed to read state: %v", err)
+	}
+	if value != "second" {
+		t.Errorf("stale write should not clobber state, got %q", value)
+	}
+}
+
+func TestSaveStateSharesVersionWithCAS(t *testing.T) {
+	store := newTestStore(t)
+
+	// A plain save participates in the same version sequence
+	if err := store.SaveState("identity", "first"); err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+	version, err := store.GetStateVersion("identity")
+	if err != nil {
+		t.Fatalf("failed to read version: %v", err)
+	}
+	if version != 1 {
+		t.Fatalf("expected version 1 after first save, got %d", version)
+	}
+
+	if err := store.SaveStateCAS("identity", 1, "second"); err != nil {
+		t.Fatalf("CAS after plain save failed: %v", err)
+	}
+
+	// A plain save between read and CAS write must surface as a conflict
+	if err := store.SaveState("identity", "interleaved"); err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+	err = store.SaveStateCAS("identity", 2, "stale")
+	if !errors.Is(err, ErrStateConflict) {
+		t.Fatalf("CAS should conflict after an interleaved plain save, got %v", err)
+	}
+
+	var value string
+	if err := store.GetState("identity", &value); err != nil {
+		t.Fatalf("failed to read state: %v", err)
+	}
+	if value != "interleaved" {
+		t.Errorf("stale CAS write should not clobber state, got %q", value)
+	}
+
+	// Repeated plain saves keep the version monotonic
+	if err := store.SaveState("identity", "fourth"); err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+	version, err = store.GetStateVersion("identity")
+	if err != nil {
+		t.Fatalf("failed to read version: %v", err)
+	}
+	if version != 4 {
+		t.Errorf("expected version 4 after four writes, got %d", version)
+	}
+}
+
+func TestRetentionPolicyProtectsMilestones(t *testing.T) {
+	store := newTestStore(t)
+
+	milestone := &MemoryRecord{
+		Content:   "first successful self-reflection",
+		Type:      "milestone",
+		Timestamp: time.Now().Add(-30 * 24 * time.Hour),
+		Strength:  0.2,
+	}
+	ordinary := &MemoryRecord{
+		Content:   "noticed the weather",
+		Type:      "episodic",
+		Timestamp: time.Now().Add(-30 * 24 * time.Hour),
+		Strength:  0.2,
+	}
+
+	if err := store.SaveMemory(milestone); err != nil {
+		t.Fatalf("failed to save milestone: %v", err)
+	}
+	if err := store.SaveMemory(ordinary); err != nil {
+		t.Fatalf("failed to save ordinary memory: %v", err)
+	}
+
+	// Aggressive pruning: everything under 0.9 is a candidate
+	deleted, err := store.PruneWeakMemories(0.9)
+	if err != nil {
+		t.Fatalf("prune failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("expected 1 memory pruned, got %d", deleted)
+	}
+
+	remaining, err := store.GetStrongMemories(0.0, 10)
+	if err != nil {
+		t.Fatalf("failed to load memories: %v", err)
+	}
+	if len(remaining) != 1 {
+		t.Fatalf("expected 1 surviving memory, got %d", len(remaining))
+	}
+	if remaining[0].Type != "milestone" {
+		t.Errorf("milestone memory should survive, got type %s", remaining[0].Type)
+	}
+}
+
+func TestRetentionImportanceFloor(t *testing.T) {
+	store := newTestStore(t)
+
+	important := &MemoryRecord{
+		Content:   "core identity insight",
+		Type:      "episodic",
+		Timestamp: time.Now().Add(-90 * 24 * time.Hour),
+		Strength:  0.85,
+	}
+	weak := &MemoryRecord{
+		Content:   "fleeting impression",
+		Type:      "episodic",
+		Timestamp: time.Now(),
+		Strength:  0.3,
+	}
+
+	if err := store.SaveMemory(important); err != nil {
+		t.Fatalf("failed to save memory: %v", err)
+	}
+	if err := store.SaveMemory(weak); err != nil {
+		t.Fatalf("failed to save memory: %v", err)
+	}
+
+	// Decay then prune: the memory above the importance floor must not
+	// decay or be removed, regardless of age
+	if err := store.DecayMemories(0.5); err != nil {
+		t.Fatalf("decay failed: %v", err)
+	}
+	if _, err := store.PruneWeakMemories(0.5); err != nil {
+		t.Fatalf("prune failed: %v", err)
+	}
+
+	remaining, err := store.GetStrongMemories(0.0, 10)
+	if err != nil {
+		t.Fatalf("failed to load memories: %v", err)
+	}
+	if len(remaining) != 1 {
+		t.Fatalf("expected 1 surviving memory, got %d", len(remaining))
+	}
+	if remaining[0].Content != "core identity insight" {
+		t.Errorf("high-importance memory should survive, got %q", remaining[0].Content)
+	}
+	if remaining[0].Strength < 0.85 {
+		t.Errorf("high-importance memory should not decay, strength = %f", remaining[0].Strength)
+	}
+}
@@ -0,0 +1,30 @@
+package persistence
+
+// Store is the persistence contract the autonomous system depends on.
+// SQLiteStore is the default implementation; alternative backends (e.g.
+// Postgres) only need to satisfy this interface.
+type Store interface {
+	// Thoughts
+	SaveThought(thought *ThoughtRecord) error
+	GetRecentThoughts(limit int) ([]*ThoughtRecord, error)
+
+	// Memories
+	SaveMemory(memory *MemoryRecord) error
+	GetStrongMemories(minStrength float64, limit int) ([]*MemoryRecord, error)
+
+	// Key-value state
+	SaveState(key string, value interface{}) error
+	GetState(key string, target interface{}) error
+
+	// Goals
+	SaveGoal(goal *GoalRecord) error
+	GetActiveGoals() ([]*GoalRecord, error)
+	UpdateGoalStatus(goalID int64, status string) error
+
+	// Lifecycle and diagnostics
+	GetStats() (map[string]interface{}, error)
+	Close() error
+}
+
+// Compile-time check that SQLiteStore satisfies the Store contract
+var _ Store = (*SQLiteStore)(nil)
@@ -0,0 +1,74 @@
+package randutil_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/EchoCog/echollama/core/deeptreeecho"
+	"github.com/EchoCog/echollama/core/goals"
+	"github.com/EchoCog/echollama/core/randutil"
+)
+
+// cognitiveCycleFingerprint seeds the default source and runs a small
+// cognitive cycle across goals, interests, and meta-thoughts, returning
+// a string that captures every randomness-dependent outcome
+func cognitiveCycleFingerprint(seed int64) string {
+	randutil.SetDefault(randutil.New(seed))
+
+	var sb strings.Builder
+
+	// Interest patterns draw initial strengths and engagement offsets
+	idgg := goals.NewInterestDrivenGoalGenerator(nil)
+	patterns := idgg.GetInterestPatterns()
+	topics := make([]string, 0, len(patterns))
+	for topic := range patterns {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	for _, topic := range topics {
+		fmt.Fprintf(&sb, "%s=%.6f;", topic, patterns[topic].Strength)
+	}
+
+	// Meta-thoughts recurse probabilistically and draw IDs
+	mcm := deeptreeecho.NewMetaCognitiveMonitor()
+	thought := mcm.GenerateMetaThought("decision_making", "reflecting on the cycle", 0)
+	fmt.Fprintf(&sb, "thought=%s;", thought.ID)
+	awareness := mcm.GetSelfAwareness()
+	fmt.Fprintf(&sb, "meta_thoughts=%v;", awareness["meta_thoughts"])
+
+	return sb.String()
+}
+
+func TestCognitiveCycleReproducibleUnderFixedSeed(t *testing.T) {
+	first := cognitiveCycleFingerprint(42)
+	second := cognitiveCycleFingerprint(42)
+
+	if first != second {
+		t.Errorf("same seed produced different cycles:\n%s\n%s", first, second)
+	}
+
+	other := cognitiveCycleFingerprint(7)
+	if first == other {
+		t.Error("different seeds should produce different cycles")
+	}
+}
+
+func TestNextIDUniqueAndReproducible(t *testing.T) {
+	a := randutil.New(1)
+	b := randutil.New(1)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id := a.NextID("proc")
+		if seen[id] {
+			t.Fatalf("duplicate ID %s", id)
+		}
+		seen[id] = true
+
+		if other := b.NextID("proc"); other != id {
+			t.Fatalf("equal seeds diverged: %s vs %s", id, other)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package skills
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PracticeEvaluator performs a practice scenario and grades the outcome
+type PracticeEvaluator interface {
+	Evaluate(scenario *PracticeScenario) (*PerformanceResult, error)
+}
+
+// PracticeLLM is the minimal LLM surface needed to attempt and grade
+// practice scenarios
+type PracticeLLM interface {
+	GenerateThought(ctx context.Context, prompt string) (string, error)
+}
+
+// LLMPracticeEvaluator runs scenarios through an LLM: it first asks the
+// model to attempt the scenario prompt, then asks it to grade that
+// attempt against the scenario's evaluation metrics
+type LLMPracticeEvaluator struct {
+	llm     PracticeLLM
+	timeout time.Duration
+}
+
+// NewLLMPracticeEvaluator creates an evaluator backed by the given LLM
+func NewLLMPracticeEvaluator(llm PracticeLLM) *LLMPracticeEvaluator {
+	return &LLMPracticeEvaluator{
+		llm:     llm,
+		timeout: 60 * time.Second,
+	}
+}
+
+// Evaluate attempts the scenario and grades the response
+func (e *LLMPracticeEvaluator) Evaluate(scenario *PracticeScenario) (*PerformanceResult, error) {
+	if e.llm == nil {
+		return nil, fmt.Errorf("no LLM configured for practice evaluation")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	// Attempt the scenario
+	response, err := e.llm.GenerateThought(ctx, scenario.Prompt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attempt scenario: %w", err)
+	}
+
+	// Grade the attempt against the scenario's metrics
+	grades, err := e.llm.GenerateThought(ctx, e.buildGradingPrompt(scenario, response))
+	if err != nil {
+		return nil, fmt.Errorf("failed to grade attempt: %w", err)
+	}
+
+	metricScores, err := parseMetricScores(grades, scenario.Evaluation.Metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return scoreAgainstCriteria(metricScores, scenario.Evaluation), nil
+}
+
+// buildGradingPrompt asks the LLM to score a response on each metric
+func (e *LLMPracticeEvaluator) buildGradingPrompt(scenario *PracticeScenario, response string) string {
+	var sb strings.Builder
+
+	sb.WriteString("Grade the following response to a practice exercise.\n\n")
+	sb.WriteString(fmt.Sprintf("Exercise: %s\n", scenario.Prompt))
+	sb.WriteString(fmt.Sprintf("Response: %s\n\n", response))
+	sb.WriteString("Score each metric from 0.0 to 1.0, one per line, in the exact format 'metric: score':\n")
+	for _, metric := range scenario.Evaluation.Metrics {
+		sb.WriteString(fmt.Sprintf("%s:\n", metric))
+	}
+
+	return sb.String()
+}
+
+// parseMetricScores extracts 'metric: score' lines from a grading response
+func parseMetricScores(grades string, metrics []string) (map[string]float64, error) {
+	scores := make(map[string]float64)
+
+	for _, line := range strings.Split(grades, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		metric := strings.ToLower(strings.TrimSpace(parts[0]))
+		value, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil {
+			continue
+		}
+
+		scores[metric] = clamp(value, 0.0, 1.0)
+	}
+
+	for _, metric := range metrics {
+		if _, ok := scores[strings.ToLower(metric)]; !ok {
+			return nil, fmt.Errorf("grading response missing metric %q", metric)
+		}
+	}
+
+	return scores, nil
+}
+
+// scoreAgainstCriteria combines metric scores into an overall result
+// using the criteria's weights and thresholds
+func scoreAgainstCriteria(metricScores map[string]float64, criteria EvaluationCriteria) *PerformanceResult {
+	result := &PerformanceResult{
+		MetricScores: make(map[string]float64, len(criteria.Metrics)),
+		Strengths:    make([]string, 0),
+		Weaknesses:   make([]string, 0),
+	}
+
+	totalWeight := 0.0
+	weighted := 0.0
+
+	for _, metric := range criteria.Metrics {
+		score := metricScores[strings.ToLower(metric)]
+		result.MetricScores[metric] = score
+
+		weight, ok := criteria.Weights[metric]
+		if !ok {
+			weight = 1.0
+		}
+		weighted += score * weight
+		totalWeight += weight
+
+		// Thresholds decide strengths and weaknesses; metrics without a
+		// threshold use the simulation cutoffs
+		if threshold, ok := criteria.Thresholds[metric]; ok {
+			if score >= threshold {
+				result.Strengths = append(result.Strengths, metric)
+			} else {
+				result.Weaknesses = append(result.Weaknesses, metric)
+			}
+		} else if score > 0.7 {
+			result.Strengths = append(result.Strengths, metric)
+		} else if score < 0.5 {
+			result.Weaknesses = append(result.Weaknesses, metric)
+		}
+	}
+
+	if totalWeight > 0 {
+		result.Score = weighted / totalWeight
+	}
+
+	return result
+}
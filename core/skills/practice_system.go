@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/EchoCog/echollama/core/randutil"
 )
 
 // SkillPracticeSystem enables autonomous skill development and measurement
@@ -30,7 +31,19 @@ type SkillPracticeSystem struct {
 	
 	// Autonomous practice goals
 	practiceGoals     []*PracticeGoal
-	
+
+	// Optional hook that trains reasoning-strategy selection from practice
+	strategyRecorder  StrategyRecorder
+
+	// Optional hook that reports aggregate proficiency to wisdom tracking
+	practicalSink     PracticalApplicationSink
+
+	// Pluggable practice evaluation (LLM-backed when configured)
+	evaluator         PracticeEvaluator
+
+	// Spaced-repetition schedule for skill reviews
+	scheduler         *reviewScheduler
+
 	// Configuration
 	practiceInterval  time.Duration
 	improvementTarget float64
@@ -85,6 +98,36 @@ func (sc SkillCategory) String() string {
 	}[sc]
 }
 
+// StrategyRecorder receives reasoning-strategy outcomes derived from
+// practice sessions. MetaCognitiveMonitor satisfies this interface via
+// its RecordStrategyOutcome method.
+type StrategyRecorder interface {
+	RecordStrategyOutcome(strategyID string, success bool, quality float64, duration time.Duration)
+}
+
+// StrategyForCategory maps a skill category to the reasoning strategy it
+// exercises, so practicing a skill also trains strategy selection
+func StrategyForCategory(category SkillCategory) string {
+	switch category {
+	case SkillCategoryLogicalReasoning, SkillCategoryTemporalReasoning:
+		return "deliberate"
+	case SkillCategoryMetaCognition, SkillCategoryGoalPlanning:
+		return "analytical"
+	case SkillCategoryPatternRecognition, SkillCategoryCreativeSynthesis,
+		SkillCategoryMemoryRetrieval, SkillCategorySocialUnderstanding:
+		return "intuitive"
+	default:
+		return "deliberate"
+	}
+}
+
+// PracticalApplicationSink receives the running skill-proficiency
+// aggregate after each practice session. wisdom.SevenDimensionalWisdom
+// satisfies this interface via its RecordPracticalApplication method.
+type PracticalApplicationSink interface {
+	RecordPracticalApplication(proficiency float64)
+}
+
 // SkillOntology defines the structure and relationships of skills
 type SkillOntology struct {
 	RootSkills      []*Skill
@@ -168,13 +211,56 @@ func NewSkillPracticeSystem() *SkillPracticeSystem {
 		practiceInterval:   30 * time.Minute,
 		improvementTarget:  0.1, // 10% improvement target
 	}
+	sps.scheduler = newReviewScheduler(sps.practiceInterval)
 	
 	// Initialize skill ontology
 	sps.initializeSkillOntology()
-	
+
 	return sps
 }
 
+// SetStrategyRecorder attaches a recorder that is notified of the
+// reasoning strategy exercised by each practice session
+func (sps *SkillPracticeSystem) SetStrategyRecorder(recorder StrategyRecorder) {
+	sps.mu.Lock()
+	defer sps.mu.Unlock()
+	sps.strategyRecorder = recorder
+}
+
+// SetPracticalApplicationSink attaches a sink that receives the aggregate
+// skill proficiency after each practice session
+func (sps *SkillPracticeSystem) SetPracticalApplicationSink(sink PracticalApplicationSink) {
+	sps.mu.Lock()
+	defer sps.mu.Unlock()
+	sps.practicalSink = sink
+}
+
+// SetPracticeEvaluator attaches an evaluator that actually performs and
+// grades practice scenarios; without one, practice is simulated
+func (sps *SkillPracticeSystem) SetPracticeEvaluator(evaluator PracticeEvaluator) {
+	sps.mu.Lock()
+	defer sps.mu.Unlock()
+	sps.evaluator = evaluator
+}
+
+// AggregateProficiency returns the mean current level across all skills,
+// used as the practical-application input to wisdom cultivation
+func (sps *SkillPracticeSystem) AggregateProficiency() float64 {
+	sps.mu.RLock()
+	defer sps.mu.RUnlock()
+
+	if len(sps.skills) == 0 {
+		return 0.0
+	}
+
+	sum := 0.0
+	for _, skill := range sps.skills {
+		sum += skill.CurrentLevel
+	}
+
+	return sum / float64(len(sps.skills))
+}
+
 // initializeSkillOntology creates the initial skill structure
 func (sps *SkillPracticeSystem) initializeSkillOntology() {
 	// Create fundamental cognitive skills
@@ -315,11 +401,75 @@ func (sps *SkillPracticeSystem) createPracticeScenariosForSkill(skill *Skill) {
 			},
 			TimeLimit: 180 * time.Second,
 		})
+	default:
+		// Categories without bespoke scenarios get a generic exercise
+		// built from the skill's own metrics
+		metrics := skill.Metrics
+		if len(metrics) == 0 {
+			metrics = []string{"quality"}
+		}
+		weights := make(map[string]float64, len(metrics))
+		for _, metric := range metrics {
+			weights[metric] = 1.0 / float64(len(metrics))
+		}
+		scenarios = append(scenarios, &PracticeScenario{
+			ID:          uuid.New().String(),
+			SkillID:     skill.ID,
+			Name:        fmt.Sprintf("%s Exercise", skill.Name),
+			Description: fmt.Sprintf("Open-ended practice for %s", skill.Name),
+			Difficulty:  skill.Difficulty,
+			Prompt:      fmt.Sprintf("Demonstrate your %s: %s", skill.Name, skill.Description),
+			Evaluation: EvaluationCriteria{
+				Metrics:    metrics,
+				Thresholds: make(map[string]float64),
+				Weights:    weights,
+			},
+			TimeLimit: 120 * time.Second,
+		})
 	}
-	
+
 	skill.PracticeScenarios = scenarios
 }
 
+// RegisterSkill adds a custom skill to the practice system. An ID is
+// assigned if empty, performance metrics are initialized, and default
+// practice scenarios are generated when the skill has none.
+func (sps *SkillPracticeSystem) RegisterSkill(skill *Skill) error {
+	if skill == nil {
+		return fmt.Errorf("skill cannot be nil")
+	}
+	if skill.Name == "" {
+		return fmt.Errorf("skill name is required")
+	}
+	if skill.Category < SkillCategoryPatternRecognition || skill.Category > SkillCategoryTemporalReasoning {
+		return fmt.Errorf("unknown skill category: %d", skill.Category)
+	}
+
+	sps.mu.Lock()
+	defer sps.mu.Unlock()
+
+	if skill.ID == "" {
+		skill.ID = uuid.New().String()
+	}
+	if _, exists := sps.skills[skill.ID]; exists {
+		return fmt.Errorf("skill already registered: %s", skill.ID)
+	}
+
+	sps.skills[skill.ID] = skill
+	sps.performanceMetrics[skill.ID] = &PerformanceMetrics{
+		SkillID:      skill.ID,
+		RecentScores: make([]float64, 0),
+	}
+
+	if len(skill.PracticeScenarios) == 0 {
+		sps.createPracticeScenariosForSkill(skill)
+	}
+
+	sps.skillOntology.RootSkills = append(sps.skillOntology.RootSkills, skill)
+
+	return nil
+}
+
 // Start begins autonomous skill practice
 func (sps *SkillPracticeSystem) Start() error {
 	sps.mu.Lock()
@@ -398,28 +548,62 @@ func (sps *SkillPracticeSystem) conductPracticeSession() {
 	
 	// Update metrics
 	sps.updatePerformanceMetrics(skill.ID, performance)
-	
-	// Store session
+
+	// Feed the outcome back into reasoning-strategy selection
+	if sps.strategyRecorder != nil {
+		strategyID := StrategyForCategory(skill.Category)
+		sps.strategyRecorder.RecordStrategyOutcome(strategyID, performance.Score >= 0.6, performance.Score, session.Duration)
+	}
+
+	// Store session and reschedule the skill's next review
 	sps.mu.Lock()
 	sps.practiceHistory = append(sps.practiceHistory, session)
 	sps.sessionsCompleted++
+	sps.scheduler.RecordOutcome(skill.ID, performance.Score, session.EndTime)
 	sps.mu.Unlock()
 	
 	// Update skill level if improved
 	if performance.Improvement > 0 {
 		sps.updateSkillLevel(skill, performance)
 	}
+
+	// Report the updated proficiency aggregate to wisdom tracking
+	if sps.practicalSink != nil {
+		sps.practicalSink.RecordPracticalApplication(sps.AggregateProficiency())
+	}
 }
 
 // selectSkillToPractice chooses which skill to practice
 func (sps *SkillPracticeSystem) selectSkillToPractice() *Skill {
 	sps.mu.RLock()
 	defer sps.mu.RUnlock()
-	
-	// Select skill with largest gap between current and target level
+
+	// Prefer the most overdue skill so well-practiced skills still get
+	// reviewed before they decay; equally overdue skills are ranked by
+	// the gap between current and target level
+	now := time.Now()
+	var overdueSkill *Skill
+	maxOverdue := time.Duration(0)
+	overdueGap := 0.0
+
+	for _, skill := range sps.skills {
+		overdue := now.Sub(sps.scheduler.NextReviewTime(skill.ID))
+		gap := skill.TargetLevel - skill.CurrentLevel
+		if overdue > maxOverdue || (overdue == maxOverdue && gap > overdueGap) {
+			maxOverdue = overdue
+			overdueGap = gap
+			overdueSkill = skill
+		}
+	}
+	if overdueSkill != nil {
+		return overdueSkill
+	}
+
+	// Nothing due yet: fall back to the largest gap between current and
+	// target level
 	var selectedSkill *Skill
 	maxGap := 0.0
-	
+
 	for _, skill := range sps.skills {
 		gap := skill.TargetLevel - skill.CurrentLevel
 		if gap > maxGap {
@@ -427,10 +611,18 @@ func (sps *SkillPracticeSystem) selectSkillToPractice() *Skill {
 			selectedSkill = skill
 		}
 	}
-	
+
 	return selectedSkill
 }
 
+// NextReviewTime returns when a skill is next due for practice under the
+// spaced-repetition schedule
+func (sps *SkillPracticeSystem) NextReviewTime(skillID string) time.Time {
+	sps.mu.RLock()
+	defer sps.mu.RUnlock()
+	return sps.scheduler.NextReviewTime(skillID)
+}
+
 // selectPracticeScenario chooses a practice scenario for a skill
 func (sps *SkillPracticeSystem) selectPracticeScenario(skill *Skill) *PracticeScenario {
 	if len(skill.PracticeScenarios) == 0 {
@@ -448,12 +640,40 @@ func (sps *SkillPracticeSystem) selectPracticeScenario(skill *Skill) *PracticeSc
 	return skill.PracticeScenarios[0]
 }
 
-// executePractice simulates practicing a skill
+// executePractice runs a practice scenario through the configured
+// evaluator, falling back to simulation when none is set or it fails
 func (sps *SkillPracticeSystem) executePractice(skill *Skill, scenario *PracticeScenario) *PerformanceResult {
-	// Simulate practice performance
-	// In real implementation, would use LLM to actually practice
-	
-	baseScore := skill.CurrentLevel + rand.Float64()*0.2 - 0.1
+	sps.mu.RLock()
+	evaluator := sps.evaluator
+	sps.mu.RUnlock()
+
+	if evaluator != nil {
+		result, err := evaluator.Evaluate(scenario)
+		if err == nil && result != nil {
+			sps.applyImprovement(skill.ID, result)
+			return result
+		}
+		if err != nil {
+			fmt.Printf("⚠️  Practice evaluation failed, falling back to simulation: %v\n", err)
+		}
+	}
+
+	return sps.simulatePractice(skill, scenario)
+}
+
+// applyImprovement fills in the improvement delta against the running
+// average for the skill
+func (sps *SkillPracticeSystem) applyImprovement(skillID string, result *PerformanceResult) {
+	metrics := sps.performanceMetrics[skillID]
+	if metrics != nil && metrics.AverageScore > 0 {
+		result.Improvement = result.Score - metrics.AverageScore
+	}
+}
+
+// simulatePractice generates a plausible practice outcome around the
+// current skill level, used when no evaluator is configured
+func (sps *SkillPracticeSystem) simulatePractice(skill *Skill, scenario *PracticeScenario) *PerformanceResult {
+	baseScore := skill.CurrentLevel + randutil.Float64()*0.2 - 0.1
 	baseScore = clamp(baseScore, 0.0, 1.0)
 	
 	result := &PerformanceResult{
@@ -466,7 +686,7 @@ func (sps *SkillPracticeSystem) executePractice(skill *Skill, scenario *Practice
 	
 	// Calculate metric scores
 	for _, metric := range scenario.Evaluation.Metrics {
-		metricScore := baseScore + rand.Float64()*0.1 - 0.05
+		metricScore := baseScore + randutil.Float64()*0.1 - 0.05
 		metricScore = clamp(metricScore, 0.0, 1.0)
 		result.MetricScores[metric] = metricScore
 		
@@ -478,12 +698,8 @@ func (sps *SkillPracticeSystem) executePractice(skill *Skill, scenario *Practice
 		}
 	}
 	
-	// Calculate improvement
-	metrics := sps.performanceMetrics[skill.ID]
-	if metrics != nil && metrics.AverageScore > 0 {
-		result.Improvement = baseScore - metrics.AverageScore
-	}
-	
+	sps.applyImprovement(skill.ID, result)
+
 	return result
 }
 
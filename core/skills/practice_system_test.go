@@ -0,0 +1,312 @@
+package skills
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"testing"
+
+	"github.com/EchoCog/echollama/core/deeptreeecho"
+	"github.com/EchoCog/echollama/core/wisdom"
+)
+
+func TestStrategyForCategory(t *testing.T) {
+	if StrategyForCategory(SkillCategoryLogicalReasoning) != "deliberate" {
+		t.Error("logical reasoning should exercise the deliberate strategy")
+	}
+	if StrategyForCategory(SkillCategoryPatternRecognition) != "intuitive" {
+		t.Error("pattern recognition should exercise the intuitive strategy")
+	}
+	if StrategyForCategory(SkillCategoryMetaCognition) != "analytical" {
+		t.Error("meta-cognition should exercise the analytical strategy")
+	}
+}
+
+func TestPracticeRecordsStrategyOutcome(t *testing.T) {
+	sps := NewSkillPracticeSystem()
+	monitor := deeptreeecho.NewMetaCognitiveMonitor()
+	sps.SetStrategyRecorder(monitor)
+
+	// Make the logical-reasoning skill the only one with a level gap so
+	// the practice loop deterministically selects it
+	for _, skill := range sps.skills {
+		if skill.Category != SkillCategoryLogicalReasoning {
+			skill.CurrentLevel = skill.TargetLevel
+		}
+	}
+
+	before := monitor.GetStrategyMetrics("deliberate")
+	if before == nil {
+		t.Fatal("deliberate strategy metrics not initialized")
+	}
+
+	sps.conductPracticeSession()
+
+	after := monitor.GetStrategyMetrics("deliberate")
+	if after.TimesUsed != before.TimesUsed+1 {
+		t.Errorf("expected deliberate TimesUsed to increase: %d -> %d", before.TimesUsed, after.TimesUsed)
+	}
+	if after.LastUsed.IsZero() {
+		t.Error("expected deliberate LastUsed to be set")
+	}
+	if after.AverageQuality == before.AverageQuality && after.SuccessRate == before.SuccessRate {
+		t.Error("expected deliberate metrics to move toward the practice outcome")
+	}
+}
+
+func TestPracticeRaisesPracticalApplication(t *testing.T) {
+	sps := NewSkillPracticeSystem()
+	sdw := wisdom.NewSevenDimensionalWisdom()
+	sps.SetPracticalApplicationSink(sdw)
+
+	before := sdw.GetDimensionValue(wisdom.DimPracticalApplication)
+
+	// Raise every skill well above the dimension's baseline while leaving
+	// a level gap so a skill is still selected for practice
+	for _, skill := range sps.skills {
+		skill.CurrentLevel = 0.9
+		skill.TargetLevel = 1.0
+	}
+
+	sps.conductPracticeSession()
+
+	after := sdw.GetDimensionValue(wisdom.DimPracticalApplication)
+	if after <= before {
+		t.Errorf("practical application should rise with skill proficiency: %f -> %f", before, after)
+	}
+	if math.Abs(after-sps.AggregateProficiency()) > 1e-9 {
+		t.Errorf("dimension %f should track the proficiency aggregate %f", after, sps.AggregateProficiency())
+	}
+}
+
+// scriptedPracticeLLM returns canned responses: first the attempt, then
+// the grading lines
+type scriptedPracticeLLM struct {
+	responses []string
+	calls     int
+}
+
+func (s *scriptedPracticeLLM) GenerateThought(ctx context.Context, prompt string) (string, error) {
+	if s.calls >= len(s.responses) {
+		return "", fmt.Errorf("no scripted response for call %d", s.calls)
+	}
+	response := s.responses[s.calls]
+	s.calls++
+	return response, nil
+}
+
+func TestLLMEvaluatorGradesAgainstCriteria(t *testing.T) {
+	llm := &scriptedPracticeLLM{responses: []string{
+		"The pattern is doubling: each term is twice the previous one.",
+		"accuracy: 0.9\nspeed: 0.4\n",
+	}}
+	evaluator := NewLLMPracticeEvaluator(llm)
+
+	scenario := &PracticeScenario{
+		Prompt: "What pattern do you see in: 2, 4, 8, 16, ...?",
+		Evaluation: EvaluationCriteria{
+			Metrics:    []string{"accuracy", "speed"},
+			Thresholds: map[string]float64{"accuracy": 0.8},
+			Weights:    map[string]float64{"accuracy": 0.7, "speed": 0.3},
+		},
+	}
+
+	result, err := evaluator.Evaluate(scenario)
+	if err != nil {
+		t.Fatalf("Evaluate failed: %v", err)
+	}
+
+	if result.MetricScores["accuracy"] != 0.9 || result.MetricScores["speed"] != 0.4 {
+		t.Errorf("metric scores not parsed: %v", result.MetricScores)
+	}
+
+	// Weighted: 0.9*0.7 + 0.4*0.3 = 0.75
+	if math.Abs(result.Score-0.75) > 1e-9 {
+		t.Errorf("weighted score wrong: %f", result.Score)
+	}
+
+	// accuracy meets its 0.8 threshold; speed falls below the 0.5 cutoff
+	if len(result.Strengths) != 1 || result.Strengths[0] != "accuracy" {
+		t.Errorf("strengths wrong: %v", result.Strengths)
+	}
+	if len(result.Weaknesses) != 1 || result.Weaknesses[0] != "speed" {
+		t.Errorf("weaknesses wrong: %v", result.Weaknesses)
+	}
+}
+
+func TestLLMEvaluatorRejectsIncompleteGrades(t *testing.T) {
+	llm := &scriptedPracticeLLM{responses: []string{
+		"attempt",
+		"accuracy: 0.9\n", // speed missing
+	}}
+	evaluator := NewLLMPracticeEvaluator(llm)
+
+	scenario := &PracticeScenario{
+		Prompt: "exercise",
+		Evaluation: EvaluationCriteria{
+			Metrics: []string{"accuracy", "speed"},
+		},
+	}
+
+	if _, err := evaluator.Evaluate(scenario); err == nil {
+		t.Error("missing metric in grades should be an error")
+	}
+}
+
+func TestExecutePracticeUsesConfiguredEvaluator(t *testing.T) {
+	sps := NewSkillPracticeSystem()
+
+	skill := sps.selectSkillToPractice()
+	scenario := sps.selectPracticeScenario(skill)
+
+	llm := &scriptedPracticeLLM{responses: []string{
+		"attempt",
+		gradesForScenario(scenario, 0.95),
+	}}
+	sps.SetPracticeEvaluator(NewLLMPracticeEvaluator(llm))
+
+	result := sps.executePractice(skill, scenario)
+	if llm.calls != 2 {
+		t.Errorf("evaluator should drive the LLM: %d calls", llm.calls)
+	}
+	for _, metric := range scenario.Evaluation.Metrics {
+		if result.MetricScores[metric] != 0.95 {
+			t.Errorf("metric %s not taken from evaluator: %f", metric, result.MetricScores[metric])
+		}
+	}
+
+	// A failing evaluator falls back to simulation instead of dropping
+	// the session
+	sps.SetPracticeEvaluator(NewLLMPracticeEvaluator(&scriptedPracticeLLM{}))
+	fallback := sps.executePractice(skill, scenario)
+	if fallback == nil || len(fallback.MetricScores) == 0 {
+		t.Error("failed evaluation should fall back to simulated practice")
+	}
+}
+
+// gradesForScenario builds a grading response giving every metric the
+// same score
+func gradesForScenario(scenario *PracticeScenario, score float64) string {
+	var sb strings.Builder
+	for _, metric := range scenario.Evaluation.Metrics {
+		sb.WriteString(fmt.Sprintf("%s: %.2f\n", metric, score))
+	}
+	return sb.String()
+}
+
+func TestReviewIntervalGrowsWithConsecutiveSuccesses(t *testing.T) {
+	rs := newReviewScheduler(30 * time.Minute)
+	now := time.Now()
+
+	// Consecutive high-quality reviews push the next review further out
+	// each time
+	var lastInterval time.Duration
+	for i := 0; i < 4; i++ {
+		rs.RecordOutcome("skill", 0.95, now)
+		interval := rs.NextReviewTime("skill").Sub(now)
+		if interval <= lastInterval {
+			t.Errorf("review %d: interval %v did not grow past %v", i+1, interval, lastInterval)
+		}
+		lastInterval = interval
+	}
+
+	// A lapse resets the schedule to the base interval
+	rs.RecordOutcome("skill", 0.3, now)
+	if got := rs.NextReviewTime("skill").Sub(now); got != 30*time.Minute {
+		t.Errorf("failed review should reset to the base interval: %v", got)
+	}
+}
+
+func TestNeverPracticedSkillsAreDueImmediately(t *testing.T) {
+	rs := newReviewScheduler(30 * time.Minute)
+	if !rs.NextReviewTime("unseen").Before(time.Now()) {
+		t.Error("unpracticed skill should already be due")
+	}
+}
+
+func TestSelectSkillToPracticePrefersOverdue(t *testing.T) {
+	sps := NewSkillPracticeSystem()
+
+	// Mark every skill as recently reviewed except one left overdue
+	now := time.Now()
+	var overdue string
+	for id := range sps.skills {
+		if overdue == "" {
+			overdue = id
+			sps.scheduler.RecordOutcome(id, 0.9, now.Add(-2*time.Hour))
+			continue
+		}
+		sps.scheduler.RecordOutcome(id, 0.9, now)
+	}
+
+	selected := sps.selectSkillToPractice()
+	if selected == nil || selected.ID != overdue {
+		t.Errorf("expected overdue skill %s, got %v", overdue, selected)
+	}
+}
+
+func TestRegisterSkillGetsPracticed(t *testing.T) {
+	sps := NewSkillPracticeSystem()
+
+	skill := &Skill{
+		Name:        "Goal Planning",
+		Description: "Ability to decompose goals into actionable steps",
+		Category:    SkillCategoryGoalPlanning,
+		Difficulty:  0.6,
+		CurrentLevel: 0.1,
+		TargetLevel: 0.9,
+		Metrics:     []string{"decomposition", "feasibility"},
+	}
+	if err := sps.RegisterSkill(skill); err != nil {
+		t.Fatalf("RegisterSkill failed: %v", err)
+	}
+	if skill.ID == "" {
+		t.Error("RegisterSkill should assign an ID")
+	}
+	if sps.performanceMetrics[skill.ID] == nil {
+		t.Error("RegisterSkill should initialize performance metrics")
+	}
+	if len(skill.PracticeScenarios) == 0 {
+		t.Fatal("RegisterSkill should generate default scenarios")
+	}
+	if skill.PracticeScenarios[0].SkillID != skill.ID {
+		t.Error("generated scenario should reference the skill")
+	}
+
+	// Close every built-in skill's gap so the new skill is the clear pick
+	for id, other := range sps.skills {
+		if id != skill.ID {
+			other.CurrentLevel = other.TargetLevel
+		}
+	}
+
+	before := sps.performanceMetrics[skill.ID].PracticeCount
+	sps.conductPracticeSession()
+	if got := sps.performanceMetrics[skill.ID].PracticeCount; got != before+1 {
+		t.Errorf("registered skill was not practiced: count %d", got)
+	}
+}
+
+func TestRegisterSkillValidation(t *testing.T) {
+	sps := NewSkillPracticeSystem()
+
+	if err := sps.RegisterSkill(nil); err == nil {
+		t.Error("nil skill should be rejected")
+	}
+	if err := sps.RegisterSkill(&Skill{Category: SkillCategoryGoalPlanning}); err == nil {
+		t.Error("unnamed skill should be rejected")
+	}
+	if err := sps.RegisterSkill(&Skill{Name: "Bad", Category: SkillCategory(99)}); err == nil {
+		t.Error("unknown category should be rejected")
+	}
+
+	skill := &Skill{Name: "Temporal", Category: SkillCategoryTemporalReasoning}
+	if err := sps.RegisterSkill(skill); err != nil {
+		t.Fatalf("valid skill rejected: %v", err)
+	}
+	if err := sps.RegisterSkill(skill); err == nil {
+		t.Error("duplicate registration should be rejected")
+	}
+}
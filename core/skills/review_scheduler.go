@@ -0,0 +1,83 @@
+package skills
+
+import (
+	"time"
+)
+
+// reviewScheduler spaces skill practice using an SM-2-style algorithm:
+// each success pushes the next review further out by the skill's ease
+// factor, while a failure resets the skill to the base interval
+type reviewScheduler struct {
+	baseInterval time.Duration
+	states       map[string]*reviewState
+}
+
+// reviewState tracks the spaced-repetition state for one skill
+type reviewState struct {
+	lastPracticed time.Time
+	interval      time.Duration
+	ease          float64
+	repetitions   int
+}
+
+const (
+	// initialEase matches the SM-2 starting ease factor
+	initialEase = 2.5
+	// minEase is the SM-2 floor below which ease never drops
+	minEase = 1.3
+	// reviewSuccessScore is the score at which practice counts as a
+	// successful review rather than a lapse
+	reviewSuccessScore = 0.6
+)
+
+// newReviewScheduler creates a scheduler with the given base interval
+func newReviewScheduler(baseInterval time.Duration) *reviewScheduler {
+	return &reviewScheduler{
+		baseInterval: baseInterval,
+		states:       make(map[string]*reviewState),
+	}
+}
+
+// RecordOutcome updates a skill's review schedule after practice
+func (rs *reviewScheduler) RecordOutcome(skillID string, score float64, now time.Time) {
+	state := rs.states[skillID]
+	if state == nil {
+		state = &reviewState{ease: initialEase}
+		rs.states[skillID] = state
+	}
+
+	// SM-2 adjusts ease from review quality on a 0-5 scale
+	quality := clamp(score, 0.0, 1.0) * 5.0
+	state.ease += 0.1 - (5.0-quality)*(0.08+(5.0-quality)*0.02)
+	if state.ease < minEase {
+		state.ease = minEase
+	}
+
+	if score >= reviewSuccessScore {
+		state.repetitions++
+		switch state.repetitions {
+		case 1:
+			state.interval = rs.baseInterval
+		case 2:
+			state.interval = time.Duration(2.5 * float64(rs.baseInterval))
+		default:
+			state.interval = time.Duration(float64(state.interval) * state.ease)
+		}
+	} else {
+		// A lapse restarts the skill at the base interval
+		state.repetitions = 0
+		state.interval = rs.baseInterval
+	}
+
+	state.lastPracticed = now
+}
+
+// NextReviewTime returns when a skill is next due for practice. Skills
+// that have never been practiced are due immediately
+func (rs *reviewScheduler) NextReviewTime(skillID string) time.Time {
+	state := rs.states[skillID]
+	if state == nil {
+		return time.Time{}
+	}
+	return state.lastPracticed.Add(state.interval)
+}
@@ -0,0 +1,156 @@
+package skills
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SkillReport is a point-in-time summary of skill progress, suitable for
+// sharing with operators
+type SkillReport struct {
+	GeneratedAt       time.Time
+	SessionsCompleted uint64
+	SkillsImproved    uint64
+	Skills            []SkillProgress
+}
+
+// SkillProgress summarizes one skill's state and recent practice history
+type SkillProgress struct {
+	SkillID        string
+	Name           string
+	Category       string
+	CurrentLevel   float64
+	TargetLevel    float64
+	Trend          float64
+	AverageScore   float64
+	BestScore      float64
+	PracticeCount  int
+	RecentOutcomes []float64 // scores of the most recent sessions, oldest first
+	LastPracticed  time.Time
+}
+
+// reportRecentSessions caps how many session outcomes appear per skill
+const reportRecentSessions = 5
+
+// GenerateReport aggregates current levels, performance metrics, and
+// recent session outcomes into a shareable summary
+func (sps *SkillPracticeSystem) GenerateReport() SkillReport {
+	sps.mu.RLock()
+	defer sps.mu.RUnlock()
+
+	report := SkillReport{
+		GeneratedAt:       time.Now(),
+		SessionsCompleted: sps.sessionsCompleted,
+		SkillsImproved:    sps.skillsImproved,
+		Skills:            make([]SkillProgress, 0, len(sps.skills)),
+	}
+
+	for _, skill := range sps.skills {
+		progress := SkillProgress{
+			SkillID:        skill.ID,
+			Name:           skill.Name,
+			Category:       skill.Category.String(),
+			CurrentLevel:   skill.CurrentLevel,
+			TargetLevel:    skill.TargetLevel,
+			RecentOutcomes: make([]float64, 0, reportRecentSessions),
+			LastPracticed:  skill.LastPracticed,
+		}
+
+		if metrics := sps.performanceMetrics[skill.ID]; metrics != nil {
+			progress.Trend = metrics.Trend
+			progress.AverageScore = metrics.AverageScore
+			progress.BestScore = metrics.BestScore
+			progress.PracticeCount = metrics.PracticeCount
+		}
+
+		for _, session := range sps.practiceHistory {
+			if session.SkillID != skill.ID || session.Performance == nil {
+				continue
+			}
+			progress.RecentOutcomes = append(progress.RecentOutcomes, session.Performance.Score)
+			if len(progress.RecentOutcomes) > reportRecentSessions {
+				progress.RecentOutcomes = progress.RecentOutcomes[1:]
+			}
+		}
+
+		report.Skills = append(report.Skills, progress)
+	}
+
+	// Stable ordering for rendering and diffing
+	sort.Slice(report.Skills, func(i, j int) bool {
+		return report.Skills[i].Name < report.Skills[j].Name
+	})
+
+	return report
+}
+
+// WriteReportMarkdown renders the current skill report as a markdown
+// table with progress bars
+func (sps *SkillPracticeSystem) WriteReportMarkdown(w io.Writer) error {
+	report := sps.GenerateReport()
+
+	if _, err := fmt.Fprintf(w, "# Skill Progress Report\n\n"); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Generated: %s\n\n", report.GeneratedAt.Format(time.RFC3339))
+	fmt.Fprintf(w, "Sessions completed: %d | Skills improved: %d\n\n", report.SessionsCompleted, report.SkillsImproved)
+
+	fmt.Fprintln(w, "| Skill | Category | Progress | Avg | Best | Trend | Sessions | Recent |")
+	fmt.Fprintln(w, "|-------|----------|----------|-----|------|-------|----------|--------|")
+
+	for _, skill := range report.Skills {
+		_, err := fmt.Fprintf(w, "| %s | %s | %s %.0f%% | %.2f | %.2f | %s | %d | %s |\n",
+			skill.Name,
+			skill.Category,
+			progressBar(skill.CurrentLevel, skill.TargetLevel),
+			skill.CurrentLevel*100,
+			skill.AverageScore,
+			skill.BestScore,
+			trendArrow(skill.Trend),
+			skill.PracticeCount,
+			formatOutcomes(skill.RecentOutcomes),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// progressBar renders current level against target as a 10-cell bar
+func progressBar(current, target float64) string {
+	const cells = 10
+	if target <= 0 {
+		target = 1.0
+	}
+	filled := int(clamp(current/target, 0.0, 1.0)*cells + 0.5)
+	return strings.Repeat("█", filled) + strings.Repeat("░", cells-filled)
+}
+
+// trendArrow summarizes the performance trend direction
+func trendArrow(trend float64) string {
+	switch {
+	case trend > 0.01:
+		return "↑"
+	case trend < -0.01:
+		return "↓"
+	default:
+		return "→"
+	}
+}
+
+// formatOutcomes joins recent session scores, oldest first
+func formatOutcomes(outcomes []float64) string {
+	if len(outcomes) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(outcomes))
+	for i, score := range outcomes {
+		parts[i] = fmt.Sprintf("%.2f", score)
+	}
+	return strings.Join(parts, ", ")
+}
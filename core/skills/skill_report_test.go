@@ -0,0 +1,107 @@
+package skills
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateReportAggregatesHistory(t *testing.T) {
+	sps := NewSkillPracticeSystem()
+
+	// Record a handful of sessions for one skill
+	var skillID string
+	for id := range sps.skills {
+		skillID = id
+		break
+	}
+	scores := []float64{0.4, 0.5, 0.6, 0.7, 0.8, 0.9}
+	for _, score := range scores {
+		sps.practiceHistory = append(sps.practiceHistory, &PracticeSession{
+			SkillID:     skillID,
+			Performance: &PerformanceResult{Score: score},
+		})
+		sps.updatePerformanceMetrics(skillID, &PerformanceResult{Score: score})
+	}
+	sps.sessionsCompleted = uint64(len(scores))
+
+	report := sps.GenerateReport()
+	if report.SessionsCompleted != uint64(len(scores)) {
+		t.Errorf("sessions completed wrong: %d", report.SessionsCompleted)
+	}
+	if len(report.Skills) != len(sps.skills) {
+		t.Errorf("report should cover every skill: %d of %d", len(report.Skills), len(sps.skills))
+	}
+
+	var progress *SkillProgress
+	for i := range report.Skills {
+		if report.Skills[i].SkillID == skillID {
+			progress = &report.Skills[i]
+		}
+	}
+	if progress == nil {
+		t.Fatal("practiced skill missing from report")
+	}
+	if progress.PracticeCount != len(scores) {
+		t.Errorf("practice count wrong: %d", progress.PracticeCount)
+	}
+	if progress.BestScore != 0.9 {
+		t.Errorf("best score wrong: %f", progress.BestScore)
+	}
+
+	// Recent outcomes keep only the newest sessions, oldest first
+	if len(progress.RecentOutcomes) != reportRecentSessions {
+		t.Fatalf("recent outcomes wrong length: %v", progress.RecentOutcomes)
+	}
+	if progress.RecentOutcomes[0] != 0.5 || progress.RecentOutcomes[len(progress.RecentOutcomes)-1] != 0.9 {
+		t.Errorf("recent outcomes wrong window: %v", progress.RecentOutcomes)
+	}
+
+	// Report ordering is stable by name
+	for i := 1; i < len(report.Skills); i++ {
+		if report.Skills[i-1].Name > report.Skills[i].Name {
+			t.Errorf("skills not sorted: %s > %s", report.Skills[i-1].Name, report.Skills[i].Name)
+		}
+	}
+}
+
+func TestWriteReportMarkdown(t *testing.T) {
+	sps := NewSkillPracticeSystem()
+
+	var sb strings.Builder
+	if err := sps.WriteReportMarkdown(&sb); err != nil {
+		t.Fatalf("WriteReportMarkdown failed: %v", err)
+	}
+	out := sb.String()
+
+	if !strings.Contains(out, "# Skill Progress Report") {
+		t.Error("report missing title")
+	}
+	if !strings.Contains(out, "| Skill | Category | Progress |") {
+		t.Error("report missing table header")
+	}
+	for _, skill := range sps.skills {
+		if !strings.Contains(out, "| "+skill.Name+" |") {
+			t.Errorf("report missing skill row for %s", skill.Name)
+		}
+	}
+	// Unpracticed skills still show partially filled progress bars
+	if !strings.Contains(out, "█") || !strings.Contains(out, "░") {
+		t.Error("report missing progress bars")
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	if got := progressBar(0.5, 1.0); got != "█████░░░░░" {
+		t.Errorf("half bar wrong: %s", got)
+	}
+	if got := progressBar(1.0, 1.0); got != "██████████" {
+		t.Errorf("full bar wrong: %s", got)
+	}
+	if got := progressBar(0.0, 1.0); got != "░░░░░░░░░░" {
+		t.Errorf("empty bar wrong: %s", got)
+	}
+	// Progress is measured against the target level
+	if got := progressBar(0.4, 0.8); got != "█████░░░░░" {
+		t.Errorf("target-relative bar wrong: %s", got)
+	}
+}
@@ -1,8 +1,12 @@
 package wisdom
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
 	"math"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -11,27 +15,36 @@ import (
 // framework as specified in the Echo9 architecture
 type SevenDimensionalWisdom struct {
 	mu sync.RWMutex
-	
+
 	// The Seven Dimensions
 	dimensions map[WisdomDimension]*DimensionState
-	
+
 	// Three Triads
 	epistemicTriad   *EpistemicTriad   // Ways of Knowing
 	cognitiveTriad   *CognitiveTriad   // Understanding Process
 	axiologicalTriad *AxiologicalTriad // Practices of Wisdom
-	
+
+	// Weighted-average weights for overall wisdom
+	weights map[WisdomDimension]float64
+
+	// Dimension alerting
+	alertCallback   func(WisdomDimension, DimensionState, string)
+	alertDropDelta  float64
+	alertStallLimit int
+	stallCounts     map[WisdomDimension]int
+
 	// Overall cultivation state
-	overallWisdom    float64
-	coherenceScore   float64
-	evolutionRate    float64
-	
+	overallWisdom  float64
+	coherenceScore float64
+	evolutionRate  float64
+
 	// Historical tracking
-	snapshots        []SevenDimWisdomSnapshot
-	cultivationLog   []CultivationEvent
-	
+	snapshots      []SevenDimWisdomSnapshot
+	cultivationLog []CultivationEvent
+
 	// Timing
-	startTime        time.Time
-	lastUpdate       time.Time
+	startTime  time.Time
+	lastUpdate time.Time
 }
 
 // WisdomDimension represents one of the seven dimensions
@@ -61,8 +74,8 @@ func (d WisdomDimension) String() string {
 
 // DimensionState tracks the state of a single wisdom dimension
 type DimensionState struct {
-	Value          float64   // Current value (0.0-1.0)
-	Trend          float64   // Rate of change
+	Value          float64 // Current value (0.0-1.0)
+	Trend          float64 // Rate of change
 	LastUpdate     time.Time
 	UpdateCount    int64
 	History        []float64 // Recent history for trend analysis
@@ -72,14 +85,14 @@ type DimensionState struct {
 
 // SevenDimWisdomSnapshot captures the complete wisdom state
 type SevenDimWisdomSnapshot struct {
-	Timestamp         time.Time
-	DimensionValues   map[WisdomDimension]float64
-	OverallWisdom     float64
-	CoherenceScore    float64
-	EpistemicBalance  float64
-	CognitiveBalance  float64
+	Timestamp          time.Time
+	DimensionValues    map[WisdomDimension]float64
+	OverallWisdom      float64
+	CoherenceScore     float64
+	EpistemicBalance   float64
+	CognitiveBalance   float64
 	AxiologicalBalance float64
-	SignificantEvents []string
+	SignificantEvents  []string
 }
 
 // CultivationEvent represents an event in wisdom cultivation
@@ -93,16 +106,16 @@ type CultivationEvent struct {
 
 // EpistemicTriad: Ways of Knowing
 type EpistemicTriad struct {
-	Propositional  float64 // Facts and theories
-	Procedural     float64 // Skills and practices
-	Perspectival   float64 // Frameworks and worldviews
-	Participatory  float64 // Identity and transformation
+	Propositional float64 // Facts and theories
+	Procedural    float64 // Skills and practices
+	Perspectival  float64 // Frameworks and worldviews
+	Participatory float64 // Identity and transformation
 }
 
 // CognitiveTriad: Understanding Process
 type CognitiveTriad struct {
-	Explanation float64 // Causal understanding
-	Realizing   float64 // Relevance realization
+	Explanation    float64 // Causal understanding
+	Realizing      float64 // Relevance realization
 	Interpretation float64 // Meaning-making
 }
 
@@ -117,27 +130,31 @@ type AxiologicalTriad struct {
 // NewSevenDimensionalWisdom creates a new seven-dimensional wisdom tracker
 func NewSevenDimensionalWisdom() *SevenDimensionalWisdom {
 	sdw := &SevenDimensionalWisdom{
-		dimensions:      make(map[WisdomDimension]*DimensionState),
-		snapshots:       make([]SevenDimWisdomSnapshot, 0, 1000),
-		cultivationLog:  make([]CultivationEvent, 0, 10000),
-		startTime:       time.Now(),
-		lastUpdate:      time.Now(),
-		epistemicTriad:  &EpistemicTriad{},
-		cognitiveTriad:  &CognitiveTriad{},
+		dimensions:       make(map[WisdomDimension]*DimensionState),
+		weights:          defaultDimensionWeights(),
+		alertDropDelta:   0.2,
+		alertStallLimit:  3,
+		stallCounts:      make(map[WisdomDimension]int),
+		snapshots:        make([]SevenDimWisdomSnapshot, 0, 1000),
+		cultivationLog:   make([]CultivationEvent, 0, 10000),
+		startTime:        time.Now(),
+		lastUpdate:       time.Now(),
+		epistemicTriad:   &EpistemicTriad{},
+		cognitiveTriad:   &CognitiveTriad{},
 		axiologicalTriad: &AxiologicalTriad{},
 	}
-	
+
 	// Initialize all seven dimensions
 	for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
 		sdw.dimensions[dim] = &DimensionState{
-			Value:       0.3, // Start with modest baseline
-			History:     make([]float64, 0, 100),
-			TargetValue: 0.8, // Aim high
-			LastUpdate:  time.Now(),
+			Value:          0.3, // Start with modest baseline
+			History:        make([]float64, 0, 100),
+			TargetValue:    0.8, // Aim high
+			LastUpdate:     time.Now(),
 			CultivationLog: make([]string, 0),
 		}
 	}
-	
+
 	return sdw
 }
 
@@ -153,9 +170,9 @@ func (sdw *SevenDimensionalWisdom) Update(
 ) {
 	sdw.mu.Lock()
 	defer sdw.mu.Unlock()
-	
+
 	now := time.Now()
-	
+
 	// Update each dimension
 	sdw.updateDimension(DimKnowledgeDepth, graphDepth, now)
 	sdw.updateDimension(DimKnowledgeBreadth, graphBreadth, now)
@@ -164,61 +181,174 @@ func (sdw *SevenDimensionalWisdom) Update(
 	sdw.updateDimension(DimReflectiveInsight, aarCoherence, now)
 	sdw.updateDimension(DimEthicalConsideration, moralityScore, now)
 	sdw.updateDimension(DimTemporalPerspective, goalTimeHorizon, now)
-	
+
 	// Calculate overall wisdom (weighted average per Echo9 spec)
-	sdw.overallWisdom = 
-		sdw.dimensions[DimKnowledgeDepth].Value * 0.15 +
-		sdw.dimensions[DimKnowledgeBreadth].Value * 0.15 +
-		sdw.dimensions[DimIntegrationLevel].Value * 0.20 +
-		sdw.dimensions[DimPracticalApplication].Value * 0.15 +
-		sdw.dimensions[DimReflectiveInsight].Value * 0.15 +
-		sdw.dimensions[DimEthicalConsideration].Value * 0.10 +
-		sdw.dimensions[DimTemporalPerspective].Value * 0.10
-	
+	sdw.recalculateOverallWisdom()
+
 	// Update triads
 	sdw.updateTriads()
-	
+
 	// Calculate coherence (how balanced are dimensions)
 	sdw.coherenceScore = sdw.calculateCoherence()
-	
+
 	// Calculate evolution rate
 	sdw.evolutionRate = sdw.calculateEvolutionRate()
-	
+
 	// Take snapshot
 	sdw.takeSnapshot()
-	
+
+	sdw.lastUpdate = now
+}
+
+// RecordPracticalApplication feeds a skill-proficiency aggregate into the
+// practical-application dimension without requiring the caller to supply
+// the full set of Update inputs. This is the hook the skills package uses
+// to report completed practice sessions.
+func (sdw *SevenDimensionalWisdom) RecordPracticalApplication(proficiency float64) {
+	sdw.mu.Lock()
+	defer sdw.mu.Unlock()
+
+	now := time.Now()
+	sdw.updateDimension(DimPracticalApplication, proficiency, now)
+	sdw.recalculateOverallWisdom()
+	sdw.updateTriads()
+	sdw.coherenceScore = sdw.calculateCoherence()
 	sdw.lastUpdate = now
 }
 
+// defaultDimensionWeights returns the weighted-average weights from the
+// Echo9 spec
+func defaultDimensionWeights() map[WisdomDimension]float64 {
+	return map[WisdomDimension]float64{
+		DimKnowledgeDepth:       0.15,
+		DimKnowledgeBreadth:     0.15,
+		DimIntegrationLevel:     0.20,
+		DimPracticalApplication: 0.15,
+		DimReflectiveInsight:    0.15,
+		DimEthicalConsideration: 0.10,
+		DimTemporalPerspective:  0.10,
+	}
+}
+
+// SetWeights replaces the overall-wisdom weights. Every dimension must
+// have a weight and the weights must sum to ~1.0.
+func (sdw *SevenDimensionalWisdom) SetWeights(weights map[WisdomDimension]float64) error {
+	sum := 0.0
+	for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
+		weight, ok := weights[dim]
+		if !ok {
+			return fmt.Errorf("missing weight for dimension %s", dim)
+		}
+		if weight < 0 {
+			return fmt.Errorf("negative weight for dimension %s", dim)
+		}
+		sum += weight
+	}
+	if math.Abs(sum-1.0) > 0.01 {
+		return fmt.Errorf("dimension weights must sum to 1.0, got %.3f", sum)
+	}
+
+	sdw.mu.Lock()
+	defer sdw.mu.Unlock()
+
+	sdw.weights = make(map[WisdomDimension]float64, len(weights))
+	for dim, weight := range weights {
+		sdw.weights[dim] = weight
+	}
+	sdw.recalculateOverallWisdom()
+
+	return nil
+}
+
+// recalculateOverallWisdom recomputes the weighted average using the
+// configured dimension weights
+func (sdw *SevenDimensionalWisdom) recalculateOverallWisdom() {
+	total := 0.0
+	for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
+		total += sdw.dimensions[dim].Value * sdw.weights[dim]
+	}
+	sdw.overallWisdom = total
+}
+
+// OnDimensionAlert registers a callback fired when a dimension drops
+// sharply or stalls below its target. The callback receives a copy of
+// the dimension state and the triggering reason.
+func (sdw *SevenDimensionalWisdom) OnDimensionAlert(callback func(WisdomDimension, DimensionState, string)) {
+	sdw.mu.Lock()
+	defer sdw.mu.Unlock()
+	sdw.alertCallback = callback
+}
+
+// SetAlertThresholds configures the drop delta and the number of
+// consecutive stalled updates that trigger dimension alerts. Non-positive
+// values leave the current setting unchanged.
+func (sdw *SevenDimensionalWisdom) SetAlertThresholds(dropDelta float64, stallUpdates int) {
+	sdw.mu.Lock()
+	defer sdw.mu.Unlock()
+	if dropDelta > 0 {
+		sdw.alertDropDelta = dropDelta
+	}
+	if stallUpdates > 0 {
+		sdw.alertStallLimit = stallUpdates
+	}
+}
+
+// checkDimensionAlerts fires the alert callback when a dimension drops
+// more than the configured delta, or sits below target with a negative
+// trend for the configured number of consecutive updates
+func (sdw *SevenDimensionalWisdom) checkDimensionAlerts(dim WisdomDimension, state *DimensionState, oldValue float64) {
+	if sdw.alertCallback == nil {
+		return
+	}
+
+	if oldValue-state.Value > sdw.alertDropDelta {
+		sdw.alertCallback(dim, *state,
+			fmt.Sprintf("dropped %.2f → %.2f (delta %.2f exceeds %.2f)",
+				oldValue, state.Value, oldValue-state.Value, sdw.alertDropDelta))
+	}
+
+	if state.Value < state.TargetValue && state.Trend < 0 {
+		sdw.stallCounts[dim]++
+		// Fire exactly once per stall episode, when the limit is reached
+		if sdw.stallCounts[dim] == sdw.alertStallLimit {
+			sdw.alertCallback(dim, *state,
+				fmt.Sprintf("below target %.2f with negative trend for %d updates",
+					state.TargetValue, sdw.alertStallLimit))
+		}
+	} else {
+		sdw.stallCounts[dim] = 0
+	}
+}
+
 // updateDimension updates a single dimension
 func (sdw *SevenDimensionalWisdom) updateDimension(dim WisdomDimension, value float64, now time.Time) {
 	state := sdw.dimensions[dim]
-	
+
 	// Clamp to [0, 1]
 	value = math.Max(0.0, math.Min(1.0, value))
-	
+
 	// Calculate trend
 	if len(state.History) > 0 {
 		state.Trend = value - state.Value
 	}
-	
+
 	// Update history (keep last 100 values)
 	state.History = append(state.History, value)
 	if len(state.History) > 100 {
 		state.History = state.History[1:]
 	}
-	
+
 	// Update value
 	oldValue := state.Value
 	state.Value = value
 	state.LastUpdate = now
 	state.UpdateCount++
-	
+
 	// Log significant changes
-	if math.Abs(value - oldValue) > 0.1 {
+	if math.Abs(value-oldValue) > 0.1 {
 		event := fmt.Sprintf("Significant change: %.2f → %.2f", oldValue, value)
 		state.CultivationLog = append(state.CultivationLog, event)
-		
+
 		sdw.cultivationLog = append(sdw.cultivationLog, CultivationEvent{
 			Timestamp:   now,
 			Type:        "dimension_change",
@@ -227,6 +357,8 @@ func (sdw *SevenDimensionalWisdom) updateDimension(dim WisdomDimension, value fl
 			Description: event,
 		})
 	}
+
+	sdw.checkDimensionAlerts(dim, state, oldValue)
 }
 
 // updateTriads updates the three wisdom triads
@@ -236,12 +368,12 @@ func (sdw *SevenDimensionalWisdom) updateTriads() {
 	sdw.epistemicTriad.Procedural = sdw.dimensions[DimPracticalApplication].Value
 	sdw.epistemicTriad.Perspectival = sdw.dimensions[DimKnowledgeBreadth].Value
 	sdw.epistemicTriad.Participatory = sdw.dimensions[DimReflectiveInsight].Value
-	
+
 	// Cognitive Triad (Understanding Process)
 	sdw.cognitiveTriad.Explanation = sdw.dimensions[DimKnowledgeDepth].Value
 	sdw.cognitiveTriad.Realizing = sdw.dimensions[DimIntegrationLevel].Value
 	sdw.cognitiveTriad.Interpretation = sdw.dimensions[DimReflectiveInsight].Value
-	
+
 	// Axiological Triad (Practices of Wisdom)
 	sdw.axiologicalTriad.Morality = sdw.dimensions[DimEthicalConsideration].Value
 	sdw.axiologicalTriad.Meaning = sdw.dimensions[DimReflectiveInsight].Value
@@ -255,25 +387,25 @@ func (sdw *SevenDimensionalWisdom) calculateCoherence() float64 {
 	for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
 		values = append(values, sdw.dimensions[dim].Value)
 	}
-	
+
 	// Calculate variance
 	mean := 0.0
 	for _, v := range values {
 		mean += v
 	}
 	mean /= float64(len(values))
-	
+
 	variance := 0.0
 	for _, v := range values {
 		diff := v - mean
 		variance += diff * diff
 	}
 	variance /= float64(len(values))
-	
+
 	// Coherence is inverse of variance (0 variance = 1.0 coherence)
 	// Use exponential decay to map variance to [0, 1]
 	coherence := math.Exp(-variance * 10.0)
-	
+
 	return coherence
 }
 
@@ -282,19 +414,19 @@ func (sdw *SevenDimensionalWisdom) calculateEvolutionRate() float64 {
 	if len(sdw.snapshots) < 2 {
 		return 0.0
 	}
-	
+
 	// Compare last two snapshots
 	current := sdw.snapshots[len(sdw.snapshots)-1]
 	previous := sdw.snapshots[len(sdw.snapshots)-2]
-	
+
 	timeDelta := current.Timestamp.Sub(previous.Timestamp).Hours()
 	if timeDelta == 0 {
 		return 0.0
 	}
-	
+
 	wisdomDelta := current.OverallWisdom - previous.OverallWisdom
 	rate := wisdomDelta / timeDelta // Change per hour
-	
+
 	return rate
 }
 
@@ -304,27 +436,27 @@ func (sdw *SevenDimensionalWisdom) takeSnapshot() {
 	for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
 		dimensionValues[dim] = sdw.dimensions[dim].Value
 	}
-	
+
 	snapshot := SevenDimWisdomSnapshot{
 		Timestamp:       time.Now(),
 		DimensionValues: dimensionValues,
 		OverallWisdom:   sdw.overallWisdom,
 		CoherenceScore:  sdw.coherenceScore,
-		EpistemicBalance: (sdw.epistemicTriad.Propositional + 
-		                   sdw.epistemicTriad.Procedural + 
-		                   sdw.epistemicTriad.Perspectival + 
-		                   sdw.epistemicTriad.Participatory) / 4.0,
+		EpistemicBalance: (sdw.epistemicTriad.Propositional +
+			sdw.epistemicTriad.Procedural +
+			sdw.epistemicTriad.Perspectival +
+			sdw.epistemicTriad.Participatory) / 4.0,
 		CognitiveBalance: (sdw.cognitiveTriad.Explanation +
-		                   sdw.cognitiveTriad.Realizing +
-		                   sdw.cognitiveTriad.Interpretation) / 3.0,
+			sdw.cognitiveTriad.Realizing +
+			sdw.cognitiveTriad.Interpretation) / 3.0,
 		AxiologicalBalance: (sdw.axiologicalTriad.Morality +
-		                     sdw.axiologicalTriad.Meaning +
-		                     sdw.axiologicalTriad.Mastery +
-		                     sdw.axiologicalTriad.Eudaimonia) / 4.0,
+			sdw.axiologicalTriad.Meaning +
+			sdw.axiologicalTriad.Mastery +
+			sdw.axiologicalTriad.Eudaimonia) / 4.0,
 	}
-	
+
 	sdw.snapshots = append(sdw.snapshots, snapshot)
-	
+
 	// Keep only last 1000 snapshots
 	if len(sdw.snapshots) > 1000 {
 		sdw.snapshots = sdw.snapshots[len(sdw.snapshots)-1000:]
@@ -335,14 +467,14 @@ func (sdw *SevenDimensionalWisdom) takeSnapshot() {
 func (sdw *SevenDimensionalWisdom) GetStatus() string {
 	sdw.mu.RLock()
 	defer sdw.mu.RUnlock()
-	
+
 	status := "🌳 Seven-Dimensional Wisdom Status\n\n"
-	
+
 	// Overall
 	status += fmt.Sprintf("Overall Wisdom: %.1f%%\n", sdw.overallWisdom*100)
 	status += fmt.Sprintf("Coherence:      %.1f%%\n", sdw.coherenceScore*100)
 	status += fmt.Sprintf("Evolution Rate: %+.4f/hour\n\n", sdw.evolutionRate)
-	
+
 	// Dimensions
 	status += "Seven Dimensions:\n"
 	for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
@@ -350,19 +482,19 @@ func (sdw *SevenDimensionalWisdom) GetStatus() string {
 		bar := makeBar(state.Value, 20)
 		status += fmt.Sprintf("  %s: %s %.1f%%\n", dim.String(), bar, state.Value*100)
 	}
-	
+
 	// Triads
 	status += "\nThree Triads:\n"
-	status += fmt.Sprintf("  Epistemic:    %.1f%%\n", 
-		(sdw.epistemicTriad.Propositional + sdw.epistemicTriad.Procedural +
-		 sdw.epistemicTriad.Perspectival + sdw.epistemicTriad.Participatory) * 25.0)
+	status += fmt.Sprintf("  Epistemic:    %.1f%%\n",
+		(sdw.epistemicTriad.Propositional+sdw.epistemicTriad.Procedural+
+			sdw.epistemicTriad.Perspectival+sdw.epistemicTriad.Participatory)*25.0)
 	status += fmt.Sprintf("  Cognitive:    %.1f%%\n",
-		(sdw.cognitiveTriad.Explanation + sdw.cognitiveTriad.Realizing +
-		 sdw.cognitiveTriad.Interpretation) * 33.33)
+		(sdw.cognitiveTriad.Explanation+sdw.cognitiveTriad.Realizing+
+			sdw.cognitiveTriad.Interpretation)*33.33)
 	status += fmt.Sprintf("  Axiological:  %.1f%%\n",
-		(sdw.axiologicalTriad.Morality + sdw.axiologicalTriad.Meaning +
-		 sdw.axiologicalTriad.Mastery + sdw.axiologicalTriad.Eudaimonia) * 25.0)
-	
+		(sdw.axiologicalTriad.Morality+sdw.axiologicalTriad.Meaning+
+			sdw.axiologicalTriad.Mastery+sdw.axiologicalTriad.Eudaimonia)*25.0)
+
 	return status
 }
 
@@ -384,7 +516,7 @@ func makeBar(value float64, width int) string {
 func (sdw *SevenDimensionalWisdom) RecordInsight(insight string, dimension WisdomDimension, impact float64) {
 	sdw.mu.Lock()
 	defer sdw.mu.Unlock()
-	
+
 	sdw.cultivationLog = append(sdw.cultivationLog, CultivationEvent{
 		Timestamp:   time.Now(),
 		Type:        "insight",
@@ -392,10 +524,10 @@ func (sdw *SevenDimensionalWisdom) RecordInsight(insight string, dimension Wisdo
 		Impact:      impact,
 		Description: insight,
 	})
-	
+
 	// Boost dimension slightly
 	state := sdw.dimensions[dimension]
-	state.Value = math.Min(1.0, state.Value + impact)
+	state.Value = math.Min(1.0, state.Value+impact)
 }
 
 // GetOverallWisdom returns the current overall wisdom score
@@ -418,3 +550,174 @@ func (sdw *SevenDimensionalWisdom) GetDimensionValue(dim WisdomDimension) float6
 	defer sdw.mu.RUnlock()
 	return sdw.dimensions[dim].Value
 }
+
+// ExportSnapshots returns a copy of the recorded wisdom snapshots,
+// oldest first
+func (sdw *SevenDimensionalWisdom) ExportSnapshots() []SevenDimWisdomSnapshot {
+	sdw.mu.RLock()
+	defer sdw.mu.RUnlock()
+
+	snapshots := make([]SevenDimWisdomSnapshot, len(sdw.snapshots))
+	for i, snapshot := range sdw.snapshots {
+		snapshots[i] = snapshot
+		snapshots[i].DimensionValues = make(map[WisdomDimension]float64, len(snapshot.DimensionValues))
+		for dim, value := range snapshot.DimensionValues {
+			snapshots[i].DimensionValues[dim] = value
+		}
+		snapshots[i].SignificantEvents = append([]string(nil), snapshot.SignificantEvents...)
+	}
+
+	return snapshots
+}
+
+// WriteSnapshotsCSV writes the snapshot history as a CSV time series:
+// one row per snapshot with each dimension value and the triad balances
+func (sdw *SevenDimensionalWisdom) WriteSnapshotsCSV(w io.Writer) error {
+	snapshots := sdw.ExportSnapshots()
+
+	cw := csv.NewWriter(w)
+
+	header := []string{"timestamp"}
+	for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
+		header = append(header, strings.ToLower(strings.ReplaceAll(dim.String(), " ", "_")))
+	}
+	header = append(header, "overall_wisdom", "coherence",
+		"epistemic_balance", "cognitive_balance", "axiological_balance")
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, snapshot := range snapshots {
+		row := []string{snapshot.Timestamp.Format(time.RFC3339)}
+		for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
+			row = append(row, strconv.FormatFloat(snapshot.DimensionValues[dim], 'f', 4, 64))
+		}
+		row = append(row,
+			strconv.FormatFloat(snapshot.OverallWisdom, 'f', 4, 64),
+			strconv.FormatFloat(snapshot.CoherenceScore, 'f', 4, 64),
+			strconv.FormatFloat(snapshot.EpistemicBalance, 'f', 4, 64),
+			strconv.FormatFloat(snapshot.CognitiveBalance, 'f', 4, 64),
+			strconv.FormatFloat(snapshot.AxiologicalBalance, 'f', 4, 64))
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// linearFit fits y = slope*x + intercept by least squares and reports
+// the R² goodness of fit
+func linearFit(xs, ys []float64) (slope, intercept, r2 float64) {
+	n := float64(len(xs))
+	if n < 2 {
+		return 0, 0, 0
+	}
+
+	meanX, meanY := 0.0, 0.0
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= n
+	meanY /= n
+
+	covXY, varX, varY := 0.0, 0.0, 0.0
+	for i := range xs {
+		dx := xs[i] - meanX
+		dy := ys[i] - meanY
+		covXY += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 {
+		return 0, meanY, 0
+	}
+
+	slope = covXY / varX
+	intercept = meanY - slope*meanX
+
+	if varY == 0 {
+		// A perfectly flat series is perfectly predicted by a flat line
+		return slope, intercept, 1.0
+	}
+	r2 = (covXY * covXY) / (varX * varY)
+
+	return slope, intercept, r2
+}
+
+// snapshotSeries extracts an elapsed-seconds/value series from the
+// snapshot history using the given accessor
+func (sdw *SevenDimensionalWisdom) snapshotSeries(value func(SevenDimWisdomSnapshot) float64) (xs, ys []float64) {
+	if len(sdw.snapshots) == 0 {
+		return nil, nil
+	}
+	origin := sdw.snapshots[0].Timestamp
+	xs = make([]float64, len(sdw.snapshots))
+	ys = make([]float64, len(sdw.snapshots))
+	for i, snapshot := range sdw.snapshots {
+		xs[i] = snapshot.Timestamp.Sub(origin).Seconds()
+		ys[i] = value(snapshot)
+	}
+	return xs, ys
+}
+
+// ForecastWisdom projects the overall wisdom score at the given horizon
+// by fitting a linear regression over the snapshot history. It returns
+// the projected value (clamped to [0, 1]) and the R² confidence of the
+// fit; with fewer than two snapshots the current score is returned with
+// zero confidence.
+func (sdw *SevenDimensionalWisdom) ForecastWisdom(horizon time.Duration) (float64, float64) {
+	sdw.mu.RLock()
+	defer sdw.mu.RUnlock()
+
+	xs, ys := sdw.snapshotSeries(func(s SevenDimWisdomSnapshot) float64 {
+		return s.OverallWisdom
+	})
+	if len(xs) < 2 {
+		return sdw.overallWisdom, 0
+	}
+
+	slope, intercept, r2 := linearFit(xs, ys)
+	atX := xs[len(xs)-1] + horizon.Seconds()
+	projected := math.Max(0.0, math.Min(1.0, intercept+slope*atX))
+
+	return projected, r2
+}
+
+// TimeToTarget estimates how long until a dimension reaches its target
+// value at the current rate of change. The second return is false when
+// the history is too short or the dimension is not trending upward; a
+// dimension already at target reports zero duration.
+func (sdw *SevenDimensionalWisdom) TimeToTarget(dim WisdomDimension) (time.Duration, bool) {
+	sdw.mu.RLock()
+	defer sdw.mu.RUnlock()
+
+	state := sdw.dimensions[dim]
+	if state.Value >= state.TargetValue {
+		return 0, true
+	}
+
+	xs, ys := sdw.snapshotSeries(func(s SevenDimWisdomSnapshot) float64 {
+		return s.DimensionValues[dim]
+	})
+	if len(xs) < 2 {
+		return 0, false
+	}
+
+	slope, intercept, _ := linearFit(xs, ys)
+	if slope <= 0 {
+		return 0, false
+	}
+
+	// Solve target = slope*x + intercept for x, relative to the latest
+	// snapshot
+	atX := (state.TargetValue - intercept) / slope
+	remaining := atX - xs[len(xs)-1]
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return time.Duration(remaining * float64(time.Second)), true
+}
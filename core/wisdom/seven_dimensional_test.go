@@ -0,0 +1,256 @@
+package wisdom
+
+import (
+	"encoding/csv"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSetWeightsShiftsOverallWisdom(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+
+	// Push ethics far above the other dimensions
+	sdw.Update(0.2, 0.2, 0.2, 0.2, 0.2, 0.9, 0.2)
+	defaultScore := sdw.GetOverallWisdom()
+
+	// Ethics-heavy weights should lift the overall score
+	err := sdw.SetWeights(map[WisdomDimension]float64{
+		DimKnowledgeDepth:       0.05,
+		DimKnowledgeBreadth:     0.05,
+		DimIntegrationLevel:     0.10,
+		DimPracticalApplication: 0.05,
+		DimReflectiveInsight:    0.05,
+		DimEthicalConsideration: 0.60,
+		DimTemporalPerspective:  0.10,
+	})
+	if err != nil {
+		t.Fatalf("SetWeights failed: %v", err)
+	}
+
+	ethicsScore := sdw.GetOverallWisdom()
+	if ethicsScore <= defaultScore {
+		t.Errorf("ethics-heavy weights should raise the score: %.3f -> %.3f", defaultScore, ethicsScore)
+	}
+
+	// 0.2 everywhere except ethics at 0.9 with weight 0.6
+	expected := 0.2*0.4 + 0.9*0.6
+	if math.Abs(ethicsScore-expected) > 1e-9 {
+		t.Errorf("weighted score wrong: got %.3f, want %.3f", ethicsScore, expected)
+	}
+}
+
+func TestSetWeightsValidation(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+
+	// Weights that do not sum to 1.0 are rejected
+	bad := defaultDimensionWeights()
+	bad[DimKnowledgeDepth] = 0.5
+	if err := sdw.SetWeights(bad); err == nil {
+		t.Error("weights summing past 1.0 should be rejected")
+	}
+
+	// A missing dimension is rejected
+	incomplete := defaultDimensionWeights()
+	delete(incomplete, DimTemporalPerspective)
+	if err := sdw.SetWeights(incomplete); err == nil {
+		t.Error("missing dimension weight should be rejected")
+	}
+
+	// The defaults themselves are valid
+	if err := sdw.SetWeights(defaultDimensionWeights()); err != nil {
+		t.Errorf("default weights should validate: %v", err)
+	}
+}
+
+func TestWriteSnapshotsCSV(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+
+	// Each Update records one snapshot
+	for i := 0; i < 3; i++ {
+		sdw.Update(0.3, 0.4, 0.5, 0.6, 0.5, 0.4, 0.3)
+	}
+
+	var sb strings.Builder
+	if err := sdw.WriteSnapshotsCSV(&sb); err != nil {
+		t.Fatalf("WriteSnapshotsCSV failed: %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(sb.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+
+	// Header plus one row per snapshot
+	if len(records) != 1+len(sdw.ExportSnapshots()) {
+		t.Errorf("row count wrong: %d records for %d snapshots", len(records), len(sdw.ExportSnapshots()))
+	}
+
+	header := records[0]
+	// timestamp + 7 dimensions + overall + coherence + 3 triad balances
+	if len(header) != 13 {
+		t.Errorf("header width wrong: %d columns", len(header))
+	}
+	if header[0] != "timestamp" || header[1] != "knowledge_depth" || header[12] != "axiological_balance" {
+		t.Errorf("unexpected header: %v", header)
+	}
+	for _, row := range records[1:] {
+		if len(row) != len(header) {
+			t.Errorf("ragged row: %v", row)
+		}
+	}
+}
+
+func TestExportSnapshotsReturnsCopies(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+	sdw.Update(0.3, 0.4, 0.5, 0.6, 0.5, 0.4, 0.3)
+
+	exported := sdw.ExportSnapshots()
+	if len(exported) != 1 {
+		t.Fatalf("expected one snapshot, got %d", len(exported))
+	}
+
+	// Mutating the export must not touch the internal buffer
+	exported[0].DimensionValues[DimKnowledgeDepth] = 99.0
+	if sdw.ExportSnapshots()[0].DimensionValues[DimKnowledgeDepth] == 99.0 {
+		t.Error("ExportSnapshots should return defensive copies")
+	}
+}
+
+func TestDimensionAlertOnSharpDrop(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+
+	var alerts []string
+	sdw.OnDimensionAlert(func(dim WisdomDimension, state DimensionState, reason string) {
+		if dim == DimEthicalConsideration {
+			alerts = append(alerts, reason)
+		}
+	})
+
+	// Establish a high ethics value, then crash it past the drop delta
+	sdw.Update(0.5, 0.5, 0.5, 0.5, 0.5, 0.9, 0.5)
+	sdw.Update(0.5, 0.5, 0.5, 0.5, 0.5, 0.3, 0.5)
+
+	if len(alerts) != 1 {
+		t.Fatalf("expected one drop alert, got %d: %v", len(alerts), alerts)
+	}
+	if !strings.Contains(alerts[0], "dropped") {
+		t.Errorf("alert reason should mention the drop: %s", alerts[0])
+	}
+}
+
+func TestDimensionAlertOnStallFiresOnce(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+	sdw.SetAlertThresholds(0.5, 3) // large drop delta so only stalls fire
+
+	alertCount := 0
+	sdw.OnDimensionAlert(func(dim WisdomDimension, state DimensionState, reason string) {
+		if dim == DimKnowledgeDepth {
+			alertCount++
+			if !strings.Contains(reason, "below target") {
+				t.Errorf("alert reason should mention the target: %s", reason)
+			}
+		}
+	})
+
+	// Gently declining below target: negative trend each update
+	values := []float64{0.50, 0.48, 0.46, 0.44, 0.42, 0.40}
+	for _, v := range values {
+		sdw.Update(v, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5)
+	}
+
+	if alertCount != 1 {
+		t.Errorf("stall alert should fire exactly once, got %d", alertCount)
+	}
+}
+
+// seedSnapshots replaces the snapshot history with a synthetic overall
+// wisdom series sampled one minute apart
+func seedSnapshots(sdw *SevenDimensionalWisdom, values []float64) {
+	origin := time.Now().Add(-time.Duration(len(values)) * time.Minute)
+	sdw.snapshots = sdw.snapshots[:0]
+	for i, v := range values {
+		dims := make(map[WisdomDimension]float64)
+		for dim := DimKnowledgeDepth; dim <= DimTemporalPerspective; dim++ {
+			dims[dim] = v
+		}
+		sdw.snapshots = append(sdw.snapshots, SevenDimWisdomSnapshot{
+			Timestamp:       origin.Add(time.Duration(i) * time.Minute),
+			DimensionValues: dims,
+			OverallWisdom:   v,
+		})
+	}
+}
+
+func TestForecastWisdomMonotonicHistory(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+
+	// Perfectly linear growth: 0.01 per minute
+	seedSnapshots(sdw, []float64{0.30, 0.31, 0.32, 0.33, 0.34})
+
+	projected, r2 := sdw.ForecastWisdom(10 * time.Minute)
+	if math.Abs(projected-0.44) > 1e-6 {
+		t.Errorf("projection wrong: got %.4f, want 0.44", projected)
+	}
+	if math.Abs(r2-1.0) > 1e-6 {
+		t.Errorf("linear history should fit perfectly: R²=%.4f", r2)
+	}
+
+	// Projections never leave [0, 1]
+	far, _ := sdw.ForecastWisdom(24 * 365 * time.Hour)
+	if far > 1.0 {
+		t.Errorf("projection should clamp to 1.0: %.4f", far)
+	}
+}
+
+func TestForecastWisdomFlatHistory(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+
+	seedSnapshots(sdw, []float64{0.5, 0.5, 0.5, 0.5})
+
+	projected, r2 := sdw.ForecastWisdom(time.Hour)
+	if math.Abs(projected-0.5) > 1e-9 {
+		t.Errorf("flat history should project flat: %.4f", projected)
+	}
+	if r2 != 1.0 {
+		t.Errorf("flat history is perfectly predicted by a flat line: R²=%.4f", r2)
+	}
+
+	// Too little history reports zero confidence
+	seedSnapshots(sdw, []float64{0.5})
+	if _, r2 := sdw.ForecastWisdom(time.Hour); r2 != 0 {
+		t.Errorf("single snapshot should have zero confidence: R²=%.4f", r2)
+	}
+}
+
+func TestTimeToTarget(t *testing.T) {
+	sdw := NewSevenDimensionalWisdom()
+
+	// Rising at 0.01/minute toward the 0.8 target from 0.34
+	seedSnapshots(sdw, []float64{0.30, 0.31, 0.32, 0.33, 0.34})
+	sdw.dimensions[DimKnowledgeDepth].Value = 0.34
+
+	remaining, ok := sdw.TimeToTarget(DimKnowledgeDepth)
+	if !ok {
+		t.Fatal("rising dimension should forecast a target time")
+	}
+	// (0.8-0.34)/0.01 = 46 minutes
+	if remaining < 45*time.Minute || remaining > 47*time.Minute {
+		t.Errorf("time to target wrong: %v", remaining)
+	}
+
+	// A flat dimension never reaches its target
+	seedSnapshots(sdw, []float64{0.5, 0.5, 0.5})
+	sdw.dimensions[DimKnowledgeBreadth].Value = 0.5
+	if _, ok := sdw.TimeToTarget(DimKnowledgeBreadth); ok {
+		t.Error("flat dimension should not forecast a target time")
+	}
+
+	// A dimension already at target reports zero immediately
+	sdw.dimensions[DimIntegrationLevel].Value = 0.9
+	remaining, ok = sdw.TimeToTarget(DimIntegrationLevel)
+	if !ok || remaining != 0 {
+		t.Errorf("at-target dimension should report zero: %v, %v", remaining, ok)
+	}
+}
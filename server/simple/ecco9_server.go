@@ -2,22 +2,66 @@ package main
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/EchoCog/echollama/core/deeptreeecho"
 	"github.com/EchoCog/echollama/core/ecco9"
 	"github.com/EchoCog/echollama/core/ecco9/drivers"
+	"github.com/EchoCog/echollama/core/goals"
 	"github.com/EchoCog/echollama/core/llm"
+	"github.com/EchoCog/echollama/core/logutil"
+	"github.com/EchoCog/echollama/core/skills"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// WebSocket upgrader for the telemetry stream
+var telemetryUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true // Allow all origins for development
+	},
+}
+
+// minTelemetryInterval caps how fast clients can ask for frames
+const minTelemetryInterval = 100 * time.Millisecond
+
+// defaultWisdomLimit is how many wisdom entries /api/wisdom returns when
+// no limit is requested
+const defaultWisdomLimit = 10
+
+// cognitiveSystems bundles the introspective subsystems exposed through
+// the read-only cognitive endpoints
+type cognitiveSystems struct {
+	goals  *goals.GoalOrchestrator
+	skills *skills.SkillPracticeSystem
+	wisdom *deeptreeecho.WisdomApplicationEngine
+}
+
+// newCognitiveSystems creates the cognitive layer with its built-in
+// ontologies and foundational wisdom
+func newCognitiveSystems() *cognitiveSystems {
+	return &cognitiveSystems{
+		goals:  goals.NewGoalOrchestrator(nil, ""),
+		skills: skills.NewSkillPracticeSystem(),
+		wisdom: deeptreeecho.NewWisdomApplicationEngine(),
+	}
+}
+
 func main() {
 	log.SetFlags(log.Ldate | log.Ltime | log.Lmicroseconds)
 	
@@ -65,8 +109,11 @@ func main() {
 		}
 	}
 	
+	// Create the cognitive layer exposed through the read endpoints
+	cognitive := newCognitiveSystems()
+
 	// Create HTTP server
-	router := setupRouter(platform, providerManager)
+	router := setupRouter(platform, providerManager, cognitive)
 	
 	// Start server
 	port := config.Ports.HTTP
@@ -100,8 +147,15 @@ func main() {
 	log.Printf("   GET  /              - Platform status dashboard")
 	log.Printf("   GET  /api/status    - Platform status (JSON)")
 	log.Printf("   GET  /api/devices   - List devices")
+	log.Printf("   GET  /api/devices/:id - Device state and metrics")
+	log.Printf("   POST /api/devices/:id/reset - Reset a device")
 	log.Printf("   GET  /api/drivers   - List drivers")
 	log.Printf("   GET  /api/health    - Health check")
+	log.Printf("   GET  /api/goals     - Active goals")
+	log.Printf("   GET  /api/skills    - Skill levels and trends")
+	log.Printf("   GET  /api/wisdom    - Top wisdom entries")
+	log.Printf("   POST /api/wisdom/apply - Apply wisdom to a context")
+	log.Printf("   GET  /api/telemetry/ws - Live telemetry (WebSocket)")
 	log.Printf("   POST /api/generate  - Generate text")
 	log.Println()
 	
@@ -110,20 +164,36 @@ func main() {
 	}
 }
 
-func setupRouter(platform *ecco9.Platform, providerManager *llm.ProviderManager) *gin.Engine {
+func setupRouter(platform *ecco9.Platform, providerManager *llm.ProviderManager, cognitive *cognitiveSystems) *gin.Engine {
+	// Callers without a cognitive layer still get valid (empty) responses
+	// from the cognitive endpoints
+	if cognitive == nil {
+		cognitive = &cognitiveSystems{}
+	}
+
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 	router.Use(gin.Recovery())
 	router.Use(cors.Default())
+	router.Use(requestID())
 	
 	// Root endpoint - Platform dashboard
 	router.GET("/", func(c *gin.Context) {
 		html := generateDashboardHTML(platform)
 		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(html))
 	})
+
+	// Prometheus metrics
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(newPlatformCollector(platform))
+	router.GET("/metrics", gin.WrapH(promhttp.HandlerFor(registry, promhttp.HandlerOpts{})))
 	
-	// API endpoints
+	// API endpoints; the dashboard stays public but the API can be
+	// locked behind a key on shared hosts
 	api := router.Group("/api")
+	if key := os.Getenv("ECCO9_API_KEY"); key != "" {
+		api.Use(apiKeyAuth(key))
+	}
 	{
 		// Platform status
 		api.GET("/status", func(c *gin.Context) {
@@ -137,7 +207,7 @@ func setupRouter(platform *ecco9.Platform, providerManager *llm.ProviderManager)
 		// List devices
 		api.GET("/devices", func(c *gin.Context) {
 			devices := make([]map[string]interface{}, 0)
-			for id, device := range platform.Devices {
+			for id, device := range platform.SnapshotDevices() {
 				state, _ := device.GetState()
 				devices = append(devices, map[string]interface{}{
 					"id":     id,
@@ -150,10 +220,57 @@ func setupRouter(platform *ecco9.Platform, providerManager *llm.ProviderManager)
 			c.JSON(http.StatusOK, gin.H{"devices": devices})
 		})
 		
+		// Single device: full state and metrics
+		api.GET("/devices/:id", func(c *gin.Context) {
+			device, err := platform.GetDevice(c.Param("id"))
+			if err != nil {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+
+			state, err := device.GetState()
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			metrics, err := device.GetMetrics()
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"id":      device.GetID(),
+				"name":    device.GetName(),
+				"type":    device.GetType(),
+				"state":   state,
+				"metrics": metrics,
+			})
+		})
+
+		// Reset a device
+		api.POST("/devices/:id/reset", func(c *gin.Context) {
+			device, err := platform.GetDevice(c.Param("id"))
+			if err != nil {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+
+			if err := device.Reset(c.Request.Context()); err != nil {
+				c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"id":    device.GetID(),
+				"reset": true,
+			})
+		})
+
 		// List drivers
 		api.GET("/drivers", func(c *gin.Context) {
 			drivers := make([]map[string]interface{}, 0)
-			for name, driver := range platform.Drivers {
+			for name, driver := range platform.SnapshotDrivers() {
 				drivers = append(drivers, map[string]interface{}{
 					"name":         name,
 					"version":      driver.GetVersion(),
@@ -165,57 +282,250 @@ func setupRouter(platform *ecco9.Platform, providerManager *llm.ProviderManager)
 		
 		// Health check
 		api.GET("/health", func(c *gin.Context) {
+			// Prefer the background monitor's cached readings; fall
+			// back to querying devices directly before the first poll
+			health := platform.Health.Snapshot()
+			if len(health) == 0 {
+				devices := platform.SnapshotDevices()
+				health = make(map[string]ecco9.HealthStatus, len(devices))
+				for id, device := range devices {
+					deviceHealth, err := device.GetHealth()
+					if err != nil {
+						continue
+					}
+					health[id] = deviceHealth
+				}
+			}
+
 			healthy := true
-			for _, device := range platform.Devices {
-				health, _ := device.GetHealth()
-				if health == ecco9.HealthStatusFailed || health == ecco9.HealthStatusCritical {
+			for _, deviceHealth := range health {
+				if deviceHealth == ecco9.HealthStatusFailed || deviceHealth == ecco9.HealthStatusCritical {
 					healthy = false
 					break
 				}
 			}
-			
+
 			status := http.StatusOK
 			if !healthy {
 				status = http.StatusServiceUnavailable
 			}
-			
+
 			c.JSON(status, gin.H{
-				"healthy": healthy,
+				"healthy":   healthy,
+				"devices":   health,
 				"timestamp": time.Now(),
 			})
 		})
 		
+		// Active goals from the cognitive layer
+		api.GET("/goals", func(c *gin.Context) {
+			goalList := make([]map[string]interface{}, 0)
+			metrics := map[string]interface{}{}
+			if cognitive.goals != nil {
+				for _, goal := range cognitive.goals.GetActiveGoals() {
+					goalList = append(goalList, map[string]interface{}{
+						"id":       goal.ID,
+						"title":    goal.Title,
+						"category": goal.Category,
+						"priority": goal.Priority,
+						"status":   goal.Status,
+						"progress": goal.Progress,
+					})
+				}
+				metrics = cognitive.goals.GetMetrics()
+			}
+			c.JSON(http.StatusOK, gin.H{
+				"goals":   goalList,
+				"metrics": metrics,
+			})
+		})
+
+		// Skill levels and practice trends
+		api.GET("/skills", func(c *gin.Context) {
+			skillList := make([]map[string]interface{}, 0)
+			var sessionsCompleted, skillsImproved uint64
+			if cognitive.skills != nil {
+				report := cognitive.skills.GenerateReport()
+				for _, skill := range report.Skills {
+					skillList = append(skillList, map[string]interface{}{
+						"id":            skill.SkillID,
+						"name":          skill.Name,
+						"category":      skill.Category,
+						"current_level": skill.CurrentLevel,
+						"target_level":  skill.TargetLevel,
+						"trend":         skill.Trend,
+					})
+				}
+				sessionsCompleted = report.SessionsCompleted
+				skillsImproved = report.SkillsImproved
+			}
+			c.JSON(http.StatusOK, gin.H{
+				"skills":             skillList,
+				"sessions_completed": sessionsCompleted,
+				"skills_improved":    skillsImproved,
+			})
+		})
+
+		// Top wisdom entries by success rate
+		api.GET("/wisdom", func(c *gin.Context) {
+			limit := defaultWisdomLimit
+			if raw := c.Query("limit"); raw != "" {
+				parsed, err := strconv.Atoi(raw)
+				if err != nil || parsed < 1 {
+					c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid limit: %s", raw)})
+					return
+				}
+				limit = parsed
+			}
+
+			wisdomList := make([]map[string]interface{}, 0)
+			if cognitive.wisdom != nil {
+				for _, entry := range cognitive.wisdom.TopWisdom(limit) {
+					wisdomList = append(wisdomList, map[string]interface{}{
+						"id":            entry.ID,
+						"content":       entry.Content,
+						"category":      entry.Category,
+						"success_rate":  entry.SuccessRate,
+						"times_applied": entry.TimesApplied,
+						"usefulness":    entry.Usefulness,
+					})
+				}
+			}
+			c.JSON(http.StatusOK, gin.H{"wisdom": wisdomList})
+		})
+
+		// Apply the best-matching wisdom to a caller-supplied context
+		api.POST("/wisdom/apply", func(c *gin.Context) {
+			var req struct {
+				Context string `json:"context"`
+			}
+
+			if err := c.BindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			if req.Context == "" {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "context is required"})
+				return
+			}
+			if cognitive.wisdom == nil {
+				c.JSON(http.StatusNotFound, gin.H{"error": "no wisdom engine configured"})
+				return
+			}
+
+			matches := cognitive.wisdom.FindRelevantWisdom(req.Context, 1)
+			if len(matches) == 0 {
+				c.JSON(http.StatusNotFound, gin.H{"error": "no relevant wisdom for this context"})
+				return
+			}
+
+			match := matches[0]
+			application := cognitive.wisdom.ApplyWisdom(match.WisdomID, req.Context)
+			if application == nil {
+				c.JSON(http.StatusNotFound, gin.H{"error": "matched wisdom no longer exists"})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"wisdom_id":     match.WisdomID,
+				"content":       match.Wisdom.Content,
+				"relevance":     match.RelevanceScore,
+				"match_type":    match.MatchType.String(),
+				"effectiveness": application.Effectiveness,
+				"outcome":       application.Outcome.String(),
+			})
+		})
+
+		// Live telemetry stream
+		api.GET("/telemetry/ws", func(c *gin.Context) {
+			interval := time.Second
+			if raw := c.Query("interval"); raw != "" {
+				parsed, err := time.ParseDuration(raw)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid interval: %v", err)})
+					return
+				}
+				if parsed < minTelemetryInterval {
+					parsed = minTelemetryInterval
+				}
+				interval = parsed
+			}
+
+			conn, err := telemetryUpgrader.Upgrade(c.Writer, c.Request, nil)
+			if err != nil {
+				log.Printf("WebSocket upgrade error: %v", err)
+				return
+			}
+			defer conn.Close()
+
+			// Reader goroutine: no client frames are expected, but
+			// reading services control frames and detects disconnects
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for {
+					if _, _, err := conn.ReadMessage(); err != nil {
+						return
+					}
+				}
+			}()
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			// First frame goes out immediately
+			if err := conn.WriteJSON(telemetrySnapshot(platform)); err != nil {
+				return
+			}
+
+			for {
+				select {
+				case <-done:
+					return
+				case <-ticker.C:
+					if err := conn.WriteJSON(telemetrySnapshot(platform)); err != nil {
+						return
+					}
+				}
+			}
+		})
+
 		// Generate text
-		api.POST("/generate", func(c *gin.Context) {
+		api.POST("/generate", rateLimit(platform.Config.RateLimit), func(c *gin.Context) {
 			var req struct {
 				Prompt string `json:"prompt"`
 				Model  string `json:"model"`
 			}
-			
+
 			if err := c.BindJSON(&req); err != nil {
 				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 				return
 			}
-			
-			// Use ecco9 platform for generation
-			response := fmt.Sprintf(
-				"🌊 ecco9 Cognitive Platform Response\n\n"+
-				"Platform: %s\n"+
-				"Firmware: %s\n"+
-				"Devices: %d active\n"+
-				"Prompt: %s\n\n"+
-				"This is a demonstration of the ecco9 virtual cognitive hardware platform. "+
-				"The platform provides device drivers, firmware interfaces, and hardware "+
-				"simulation for Deep Tree Echo's embodied cognition architecture.\n",
-				"ecco9",
-				platform.Firmware.Version,
-				len(platform.Devices),
-				req.Prompt,
-			)
-			
+			if req.Prompt == "" {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "prompt is required"})
+				return
+			}
+
+			// Route the prompt through the registered providers; the
+			// optional model field selects a specific provider
+			response, err := providerManager.GenerateWithProvider(
+				c.Request.Context(), req.Model, req.Prompt, llm.DefaultGenerateOptions())
+			if err != nil {
+				c.JSON(http.StatusBadGateway, gin.H{
+					"error":      fmt.Sprintf("generation failed: %v", err),
+					"request_id": c.GetString(requestIDKey),
+				})
+				return
+			}
+
 			c.JSON(http.StatusOK, gin.H{
 				"response": response,
 				"platform": "ecco9",
+				"model":    req.Model,
+				"usage": gin.H{
+					"prompt_tokens":     estimateTokens(req.Prompt),
+					"completion_tokens": estimateTokens(response),
+				},
 			})
 		})
 	}
@@ -223,12 +533,263 @@ func setupRouter(platform *ecco9.Platform, providerManager *llm.ProviderManager)
 	return router
 }
 
+// ipRateLimiter hands out a token bucket per client IP
+type ipRateLimiter struct {
+	mu        sync.Mutex
+	rps       float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// idleWindow is how long an empty bucket takes to refill completely; a
+// bucket untouched for this long behaves exactly like a fresh one
+func (rl *ipRateLimiter) idleWindow() time.Duration {
+	return time.Duration(rl.burst / rl.rps * float64(time.Second))
+}
+
+// sweep evicts buckets idle past the refill window. Without eviction
+// the map grows one entry per client IP forever, which a source cycling
+// spoofed addresses can exploit. Caller must hold rl.mu.
+func (rl *ipRateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < rl.idleWindow() {
+		return
+	}
+	for ip, bucket := range rl.buckets {
+		if now.Sub(bucket.last) >= rl.idleWindow() {
+			delete(rl.buckets, ip)
+		}
+	}
+	rl.lastSweep = now
+}
+
+// allow consumes one token for the client, reporting how long to wait
+// when the bucket is empty
+func (rl *ipRateLimiter) allow(ip string) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	rl.sweep(now)
+
+	bucket, exists := rl.buckets[ip]
+	if !exists {
+		bucket = &tokenBucket{tokens: rl.burst, last: now}
+		rl.buckets[ip] = bucket
+	}
+
+	// Refill at the sustained rate, capped at the burst size
+	bucket.tokens = math.Min(rl.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*rl.rps)
+	bucket.last = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+
+	wait := time.Duration((1 - bucket.tokens) / rl.rps * float64(time.Second))
+	return false, wait
+}
+
+// rateLimit caps request rates per client IP with a token bucket,
+// returning 429 with a Retry-After header when exceeded. A zero RPS
+// disables limiting.
+func rateLimit(config ecco9.RateLimitConfig) gin.HandlerFunc {
+	if config.GenerateRPS <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	burst := config.GenerateBurst
+	if burst < 1 {
+		burst = 1
+	}
+	limiter := &ipRateLimiter{
+		rps:       config.GenerateRPS,
+		burst:     float64(burst),
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+
+	return func(c *gin.Context) {
+		allowed, wait := limiter.allow(c.ClientIP())
+		if !allowed {
+			c.Header("Retry-After", fmt.Sprintf("%d", int(math.Ceil(wait.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// requestIDHeader carries the correlation ID between client and server
+const requestIDHeader = "X-Request-ID"
+
+// requestIDKey is where the middleware stores the ID in the gin context
+const requestIDKey = "request_id"
+
+// requestID tags every request with a correlation ID — propagating the
+// client's X-Request-ID when present — and logs the request outcome
+// with that ID through the shared structured logger
+func requestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+		if id == "" {
+			id = generateRequestID()
+		}
+		c.Set(requestIDKey, id)
+		c.Header(requestIDHeader, id)
+
+		start := time.Now()
+		c.Next()
+
+		logutil.Logger().Info("request completed",
+			logutil.Emoji("📡"),
+			"request_id", id,
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", c.Writer.Status(),
+			"latency", time.Since(start))
+	}
+}
+
+func generateRequestID() string {
+	return fmt.Sprintf("req_%d", time.Now().UnixNano())
+}
+
+// apiKeyAuth rejects requests whose Authorization header does not carry
+// the configured API key (with or without a "Bearer " prefix)
+func apiKeyAuth(key string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(key)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or missing API key"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// platformCollector translates platform state and DeviceMetrics into
+// Prometheus metrics at scrape time
+type platformCollector struct {
+	platform *ecco9.Platform
+
+	deviceOperations *prometheus.Desc
+	deviceErrors     *prometheus.Desc
+	deviceLatency    *prometheus.Desc
+	deviceHealthy    *prometheus.Desc
+	deviceCount      *prometheus.Desc
+	driverCount      *prometheus.Desc
+	uptimeSeconds    *prometheus.Desc
+}
+
+// newPlatformCollector creates a collector reading from the platform
+func newPlatformCollector(platform *ecco9.Platform) *platformCollector {
+	return &platformCollector{
+		platform: platform,
+		deviceOperations: prometheus.NewDesc("ecco9_device_operations_total",
+			"Total operations processed by a device", []string{"device"}, nil),
+		deviceErrors: prometheus.NewDesc("ecco9_device_errors_total",
+			"Total errors recorded by a device", []string{"device"}, nil),
+		deviceLatency: prometheus.NewDesc("ecco9_device_average_latency_seconds",
+			"Average operation latency of a device", []string{"device"}, nil),
+		deviceHealthy: prometheus.NewDesc("ecco9_device_healthy",
+			"Whether a device is healthy (1) or degraded/critical/failed (0)",
+			[]string{"device", "health"}, nil),
+		deviceCount: prometheus.NewDesc("ecco9_devices",
+			"Number of registered devices", nil, nil),
+		driverCount: prometheus.NewDesc("ecco9_drivers",
+			"Number of registered drivers", nil, nil),
+		uptimeSeconds: prometheus.NewDesc("ecco9_uptime_seconds",
+			"Seconds since the platform booted", nil, nil),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (pc *platformCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pc.deviceOperations
+	ch <- pc.deviceErrors
+	ch <- pc.deviceLatency
+	ch <- pc.deviceHealthy
+	ch <- pc.deviceCount
+	ch <- pc.driverCount
+	ch <- pc.uptimeSeconds
+}
+
+// Collect implements prometheus.Collector
+func (pc *platformCollector) Collect(ch chan<- prometheus.Metric) {
+	devices := pc.platform.SnapshotDevices()
+
+	ch <- prometheus.MustNewConstMetric(pc.deviceCount, prometheus.GaugeValue,
+		float64(len(devices)))
+	ch <- prometheus.MustNewConstMetric(pc.driverCount, prometheus.GaugeValue,
+		float64(len(pc.platform.SnapshotDrivers())))
+	ch <- prometheus.MustNewConstMetric(pc.uptimeSeconds, prometheus.GaugeValue,
+		time.Since(pc.platform.BootTime).Seconds())
+
+	for id, device := range devices {
+		metrics, err := device.GetMetrics()
+		if err != nil {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(pc.deviceOperations, prometheus.CounterValue,
+			float64(metrics.OperationCount), id)
+		ch <- prometheus.MustNewConstMetric(pc.deviceErrors, prometheus.CounterValue,
+			float64(metrics.ErrorCount), id)
+		ch <- prometheus.MustNewConstMetric(pc.deviceLatency, prometheus.GaugeValue,
+			metrics.AverageLatency.Seconds(), id)
+
+		health, err := device.GetHealth()
+		if err != nil {
+			continue
+		}
+		healthy := 0.0
+		if health == ecco9.HealthStatusHealthy {
+			healthy = 1.0
+		}
+		ch <- prometheus.MustNewConstMetric(pc.deviceHealthy, prometheus.GaugeValue,
+			healthy, id, string(health))
+	}
+}
+
+// estimateTokens approximates token counts from text length; providers
+// do not report exact usage through the Generate API
+func estimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+// telemetrySnapshot collects the platform status and every device's
+// metrics into one telemetry frame
+func telemetrySnapshot(platform *ecco9.Platform) gin.H {
+	registered := platform.SnapshotDevices()
+	devices := make(map[string]interface{}, len(registered))
+	for id, device := range registered {
+		metrics, err := device.GetMetrics()
+		if err != nil {
+			continue
+		}
+		devices[id] = metrics
+	}
+
+	return gin.H{
+		"timestamp": time.Now(),
+		"status":    platform.GetStatus(),
+		"devices":   devices,
+	}
+}
+
 func generateDashboardHTML(platform *ecco9.Platform) string {
 	status := platform.GetStatus()
 	statusJSON, _ := json.MarshalIndent(status, "", "  ")
 	
 	devices := make([]map[string]interface{}, 0)
-	for id, device := range platform.Devices {
+	for id, device := range platform.SnapshotDevices() {
 		state, _ := device.GetState()
 		devices = append(devices, map[string]interface{}{
 			"id":     id,
@@ -250,7 +811,7 @@ func generateDashboardHTML(platform *ecco9.Platform) string {
     <style>
         body {
             font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif;
-            background: linear-gradient(135deg, #667eea 0%s, #764ba2 100%s);
+            background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
             color: #fff;
             margin: 0;
             padding: 20px;
@@ -335,8 +896,15 @@ func generateDashboardHTML(platform *ecco9.Platform) string {
             <ul class="endpoints">
                 <li><code>GET  /api/status</code> - Platform status</li>
                 <li><code>GET  /api/devices</code> - List devices</li>
+                <li><code>GET  /api/devices/:id</code> - Device state and metrics</li>
+                <li><code>POST /api/devices/:id/reset</code> - Reset a device</li>
                 <li><code>GET  /api/drivers</code> - List drivers</li>
                 <li><code>GET  /api/health</code> - Health check</li>
+                <li><code>GET  /api/goals</code> - Active goals</li>
+                <li><code>GET  /api/skills</code> - Skill levels and trends</li>
+                <li><code>GET  /api/wisdom</code> - Top wisdom entries</li>
+                <li><code>POST /api/wisdom/apply</code> - Apply wisdom to a context</li>
+                <li><code>GET  /api/telemetry/ws</code> - Live telemetry (WebSocket)</li>
                 <li><code>POST /api/generate</code> - Generate text</li>
             </ul>
         </div>
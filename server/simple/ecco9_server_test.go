@@ -0,0 +1,783 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EchoCog/echollama/core/ecco9"
+	"github.com/EchoCog/echollama/core/ecco9/drivers"
+	"github.com/EchoCog/echollama/core/goals"
+	"github.com/EchoCog/echollama/core/llm"
+	"github.com/EchoCog/echollama/core/logutil"
+	"github.com/gorilla/websocket"
+)
+
+// mockProvider is a scripted LLM provider for handler tests
+type mockProvider struct {
+	mu      sync.Mutex
+	name    string
+	reply   string
+	err     error
+	prompts []string
+}
+
+func (p *mockProvider) Generate(ctx context.Context, prompt string, opts llm.GenerateOptions) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.prompts = append(p.prompts, prompt)
+	return p.reply, p.err
+}
+
+func (p *mockProvider) StreamGenerate(ctx context.Context, prompt string, opts llm.GenerateOptions) (<-chan llm.StreamChunk, error) {
+	return nil, fmt.Errorf("streaming not supported")
+}
+
+func (p *mockProvider) Name() string    { return p.name }
+func (p *mockProvider) Available() bool { return true }
+func (p *mockProvider) MaxTokens() int  { return 4096 }
+
+// newTelemetryTestServer boots a minimal platform with one emotion device
+// and serves the router over httptest
+func newTelemetryTestServer(t *testing.T) (*httptest.Server, *llm.ProviderManager) {
+	t.Helper()
+
+	platform := ecco9.NewPlatform(ecco9.DefaultConfiguration())
+	ctx := context.Background()
+	if err := platform.Boot(ctx); err != nil {
+		t.Fatalf("failed to boot platform: %v", err)
+	}
+
+	driver := drivers.NewEmotionDriver()
+	if err := driver.Load(drivers.DefaultEmotionConfig()); err != nil {
+		t.Fatalf("failed to load emotion driver: %v", err)
+	}
+	for _, device := range driver.ListDevices() {
+		if err := device.Initialize(ctx); err != nil {
+			t.Fatalf("failed to initialize device %s: %v", device.GetID(), err)
+		}
+		if err := platform.RegisterDevice(device); err != nil {
+			t.Fatalf("failed to register device %s: %v", device.GetID(), err)
+		}
+	}
+
+	providerManager := llm.NewProviderManager()
+	server := httptest.NewServer(setupRouter(platform, providerManager, newCognitiveSystems()))
+	t.Cleanup(server.Close)
+	return server, providerManager
+}
+
+func TestTelemetryWebSocketStreamsFrames(t *testing.T) {
+	server, _ := newTelemetryTestServer(t)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/telemetry/ws?interval=100ms"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("failed to dial telemetry socket: %v", err)
+	}
+	defer conn.Close()
+
+	// The immediate frame plus one ticked frame
+	for i := 0; i < 2; i++ {
+		var frame struct {
+			Timestamp time.Time              `json:"timestamp"`
+			Status    map[string]interface{} `json:"status"`
+			Devices   map[string]interface{} `json:"devices"`
+		}
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		if err := conn.ReadJSON(&frame); err != nil {
+			t.Fatalf("failed to read frame %d: %v", i, err)
+		}
+
+		if frame.Timestamp.IsZero() {
+			t.Errorf("frame %d missing timestamp", i)
+		}
+		if frame.Status == nil {
+			t.Errorf("frame %d missing platform status", i)
+		}
+		if _, ok := frame.Devices["emotion0"]; !ok {
+			t.Errorf("frame %d missing emotion0 metrics: %v", i, frame.Devices)
+		}
+	}
+}
+
+func TestGetDeviceByID(t *testing.T) {
+	server, _ := newTelemetryTestServer(t)
+
+	resp, err := http.Get(server.URL + "/api/devices/emotion0")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var body struct {
+		ID      string                 `json:"id"`
+		Name    string                 `json:"name"`
+		State   map[string]interface{} `json:"state"`
+		Metrics map[string]interface{} `json:"metrics"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.ID != "emotion0" {
+		t.Errorf("wrong device id: %s", body.ID)
+	}
+	if body.State == nil || body.Metrics == nil {
+		t.Error("response should include state and metrics")
+	}
+}
+
+func TestGetDeviceUnknownID(t *testing.T) {
+	server, _ := newTelemetryTestServer(t)
+
+	resp, err := http.Get(server.URL + "/api/devices/nope")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown device should return 404, got %d", resp.StatusCode)
+	}
+}
+
+func TestResetDevice(t *testing.T) {
+	server, _ := newTelemetryTestServer(t)
+
+	resp, err := http.Post(server.URL+"/api/devices/emotion0/reset", "application/json", nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var body struct {
+		ID    string `json:"id"`
+		Reset bool   `json:"reset"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if !body.Reset {
+		t.Error("reset flag should be true")
+	}
+
+	// Unknown devices cannot be reset
+	missing, err := http.Post(server.URL+"/api/devices/nope/reset", "application/json", nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer missing.Body.Close()
+	if missing.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown device should return 404, got %d", missing.StatusCode)
+	}
+}
+
+func TestGenerateRoutesThroughProvider(t *testing.T) {
+	server, providerManager := newTelemetryTestServer(t)
+
+	provider := &mockProvider{name: "mock", reply: "echoed thought"}
+	if err := providerManager.RegisterProvider(provider); err != nil {
+		t.Fatalf("failed to register provider: %v", err)
+	}
+
+	payload := bytes.NewBufferString(`{"prompt": "hello there"}`)
+	resp, err := http.Post(server.URL+"/api/generate", "application/json", payload)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Response string `json:"response"`
+		Usage    struct {
+			PromptTokens     int `json:"prompt_tokens"`
+			CompletionTokens int `json:"completion_tokens"`
+		} `json:"usage"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.Response != "echoed thought" {
+		t.Errorf("provider response not returned: %q", body.Response)
+	}
+	if body.Usage.PromptTokens <= 0 || body.Usage.CompletionTokens <= 0 {
+		t.Errorf("usage should be reported: %+v", body.Usage)
+	}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	if len(provider.prompts) != 1 || provider.prompts[0] != "hello there" {
+		t.Errorf("prompt did not reach the provider: %v", provider.prompts)
+	}
+}
+
+func TestGenerateProviderErrorReturns502(t *testing.T) {
+	server, providerManager := newTelemetryTestServer(t)
+
+	provider := &mockProvider{name: "mock", err: fmt.Errorf("provider unavailable")}
+	if err := providerManager.RegisterProvider(provider); err != nil {
+		t.Fatalf("failed to register provider: %v", err)
+	}
+
+	payload := bytes.NewBufferString(`{"prompt": "hello there"}`)
+	resp, err := http.Post(server.URL+"/api/generate", "application/json", payload)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("provider failure should return 502, got %d", resp.StatusCode)
+	}
+}
+
+// newGenerateTestServer serves the router with a scripted provider and
+// the given rate limits
+func newGenerateTestServer(t *testing.T, limits ecco9.RateLimitConfig) *httptest.Server {
+	t.Helper()
+
+	config := ecco9.DefaultConfiguration()
+	config.RateLimit = limits
+	platform := ecco9.NewPlatform(config)
+
+	providerManager := llm.NewProviderManager()
+	if err := providerManager.RegisterProvider(&mockProvider{name: "mock", reply: "ok"}); err != nil {
+		t.Fatalf("failed to register provider: %v", err)
+	}
+
+	server := httptest.NewServer(setupRouter(platform, providerManager, newCognitiveSystems()))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGenerateRateLimitTripsAfterBurst(t *testing.T) {
+	server := newGenerateTestServer(t, ecco9.RateLimitConfig{GenerateRPS: 0.5, GenerateBurst: 3})
+
+	post := func() *http.Response {
+		resp, err := http.Post(server.URL+"/api/generate", "application/json",
+			bytes.NewBufferString(`{"prompt": "hi"}`))
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		return resp
+	}
+
+	// The burst allowance passes
+	for i := 0; i < 3; i++ {
+		resp := post()
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("request %d within burst should pass, got %d", i, resp.StatusCode)
+		}
+	}
+
+	// The next request trips the limiter
+	resp := post()
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("request past burst should return 429, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Retry-After") == "" {
+		t.Error("429 response should carry a Retry-After header")
+	}
+}
+
+func TestGenerateRateLimitDisabledWhenZero(t *testing.T) {
+	server := newGenerateTestServer(t, ecco9.RateLimitConfig{})
+
+	for i := 0; i < 10; i++ {
+		resp, err := http.Post(server.URL+"/api/generate", "application/json",
+			bytes.NewBufferString(`{"prompt": "hi"}`))
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unlimited server should accept request %d, got %d", i, resp.StatusCode)
+		}
+	}
+}
+
+func TestGenerateRequiresPrompt(t *testing.T) {
+	server, _ := newTelemetryTestServer(t)
+
+	payload := bytes.NewBufferString(`{}`)
+	resp, err := http.Post(server.URL+"/api/generate", "application/json", payload)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("missing prompt should return 400, got %d", resp.StatusCode)
+	}
+}
+
+func TestAPIOpenWhenKeyUnset(t *testing.T) {
+	server, _ := newTelemetryTestServer(t)
+
+	resp, err := http.Get(server.URL + "/api/status")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("unconfigured server should be open, got %d", resp.StatusCode)
+	}
+}
+
+func TestAPIKeyAuthorization(t *testing.T) {
+	t.Setenv("ECCO9_API_KEY", "test-secret")
+	server, _ := newTelemetryTestServer(t)
+
+	// Missing key is rejected
+	resp, err := http.Get(server.URL + "/api/status")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("missing key should return 401, got %d", resp.StatusCode)
+	}
+
+	// Wrong key is rejected
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/status", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("wrong key should return 401, got %d", resp.StatusCode)
+	}
+
+	// Correct key is accepted
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/api/status", nil)
+	req.Header.Set("Authorization", "Bearer test-secret")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("correct key should return 200, got %d", resp.StatusCode)
+	}
+
+	// The dashboard stays public
+	resp, err = http.Get(server.URL + "/")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("dashboard should stay public, got %d", resp.StatusCode)
+	}
+}
+
+func TestMetricsEndpointExposesPlatformMetrics(t *testing.T) {
+	server, _ := newTelemetryTestServer(t)
+
+	resp, err := http.Get(server.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+
+	expected := []string{
+		"ecco9_devices",
+		"ecco9_drivers",
+		"ecco9_uptime_seconds",
+		`ecco9_device_operations_total{device="emotion0"}`,
+		`ecco9_device_errors_total{device="emotion0"}`,
+		`ecco9_device_average_latency_seconds{device="emotion0"}`,
+		`ecco9_device_healthy{device="emotion0",health="healthy"} 1`,
+	}
+	for _, metric := range expected {
+		if !strings.Contains(string(body), metric) {
+			t.Errorf("metrics output missing %q", metric)
+		}
+	}
+}
+
+func TestTelemetryWebSocketRejectsBadInterval(t *testing.T) {
+	server, _ := newTelemetryTestServer(t)
+
+	resp, err := http.Get(server.URL + "/api/telemetry/ws?interval=soon")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid interval should return 400, got %d", resp.StatusCode)
+	}
+}
+
+func TestCognitiveEndpoints(t *testing.T) {
+	platform := ecco9.NewPlatform(ecco9.DefaultConfiguration())
+	cognitive := newCognitiveSystems()
+
+	if err := cognitive.goals.InjectGoal(&goals.Goal{
+		ID:       "goal_test",
+		Title:    "Practice synthesis",
+		Category: goals.CategorySkillDevelopment,
+		Priority: 7,
+		Status:   goals.StatusActive,
+		Progress: 0.4,
+	}); err != nil {
+		t.Fatalf("failed to inject goal: %v", err)
+	}
+
+	server := httptest.NewServer(setupRouter(platform, llm.NewProviderManager(), cognitive))
+	defer server.Close()
+
+	getJSON := func(path string, target interface{}) {
+		t.Helper()
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s failed: %v", path, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s returned %d", path, resp.StatusCode)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+			t.Fatalf("GET %s returned invalid JSON: %v", path, err)
+		}
+	}
+
+	var goalsResp struct {
+		Goals []struct {
+			Title    string  `json:"title"`
+			Category string  `json:"category"`
+			Progress float64 `json:"progress"`
+		} `json:"goals"`
+		Metrics map[string]interface{} `json:"metrics"`
+	}
+	getJSON("/api/goals", &goalsResp)
+	if len(goalsResp.Goals) != 1 || goalsResp.Goals[0].Title != "Practice synthesis" {
+		t.Fatalf("injected goal missing from response: %+v", goalsResp.Goals)
+	}
+	if goalsResp.Goals[0].Category != "skill_development" || goalsResp.Goals[0].Progress != 0.4 {
+		t.Errorf("goal fields wrong: %+v", goalsResp.Goals[0])
+	}
+	if goalsResp.Metrics["active_goals"] == nil {
+		t.Error("goals response should include orchestrator metrics")
+	}
+
+	var skillsResp struct {
+		Skills []struct {
+			Name         string  `json:"name"`
+			Category     string  `json:"category"`
+			CurrentLevel float64 `json:"current_level"`
+			TargetLevel  float64 `json:"target_level"`
+		} `json:"skills"`
+	}
+	getJSON("/api/skills", &skillsResp)
+	if len(skillsResp.Skills) == 0 {
+		t.Fatal("skill ontology should not be empty")
+	}
+	for _, skill := range skillsResp.Skills {
+		if skill.Name == "" || skill.Category == "" || skill.TargetLevel <= 0 {
+			t.Errorf("incomplete skill entry: %+v", skill)
+		}
+	}
+
+	var wisdomResp struct {
+		Wisdom []struct {
+			Content     string  `json:"content"`
+			SuccessRate float64 `json:"success_rate"`
+		} `json:"wisdom"`
+	}
+	getJSON("/api/wisdom", &wisdomResp)
+	if len(wisdomResp.Wisdom) == 0 {
+		t.Fatal("foundational wisdom should not be empty")
+	}
+	if len(wisdomResp.Wisdom) > defaultWisdomLimit {
+		t.Errorf("default limit exceeded: %d entries", len(wisdomResp.Wisdom))
+	}
+
+	// Limit is honored and validated
+	var limited struct {
+		Wisdom []json.RawMessage `json:"wisdom"`
+	}
+	getJSON("/api/wisdom?limit=2", &limited)
+	if len(limited.Wisdom) != 2 {
+		t.Errorf("limit=2 should return 2 entries, got %d", len(limited.Wisdom))
+	}
+
+	resp, err := http.Get(server.URL + "/api/wisdom?limit=zero")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid limit should return 400, got %d", resp.StatusCode)
+	}
+}
+
+func TestCognitiveEndpointsEmptyWithoutSubsystems(t *testing.T) {
+	platform := ecco9.NewPlatform(ecco9.DefaultConfiguration())
+
+	// No cognitive layer at all: endpoints still answer with empty lists
+	server := httptest.NewServer(setupRouter(platform, llm.NewProviderManager(), nil))
+	defer server.Close()
+
+	for path, field := range map[string]string{
+		"/api/goals":  "goals",
+		"/api/skills": "skills",
+		"/api/wisdom": "wisdom",
+	} {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s failed: %v", path, err)
+		}
+
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("GET %s returned invalid JSON: %v", path, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("GET %s returned %d without a cognitive layer", path, resp.StatusCode)
+		}
+
+		var list []json.RawMessage
+		if err := json.Unmarshal(body[field], &list); err != nil {
+			t.Errorf("GET %s field %q should be a list: %v", path, field, err)
+		} else if len(list) != 0 {
+			t.Errorf("GET %s should return an empty %q list, got %d entries", path, field, len(list))
+		}
+	}
+}
+
+func TestApplyWisdomEndpoint(t *testing.T) {
+	platform := ecco9.NewPlatform(ecco9.DefaultConfiguration())
+	server := httptest.NewServer(setupRouter(platform, llm.NewProviderManager(), newCognitiveSystems()))
+	defer server.Close()
+
+	// A context matching no wisdom returns 404. This runs first: once
+	// wisdom has been applied, its recency boosts relevance for every
+	// later query
+	miss, err := http.Post(server.URL+"/api/wisdom/apply", "application/json",
+		bytes.NewBufferString(`{"context": "zzz qqq xxx"}`))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	miss.Body.Close()
+	if miss.StatusCode != http.StatusNotFound {
+		t.Errorf("irrelevant context should return 404, got %d", miss.StatusCode)
+	}
+
+	payload := bytes.NewBufferString(`{"context": "learning from experience drives growth"}`)
+	resp, err := http.Post(server.URL+"/api/wisdom/apply", "application/json", payload)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var body struct {
+		WisdomID      string  `json:"wisdom_id"`
+		Content       string  `json:"content"`
+		Relevance     float64 `json:"relevance"`
+		MatchType     string  `json:"match_type"`
+		Effectiveness float64 `json:"effectiveness"`
+		Outcome       string  `json:"outcome"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.WisdomID == "" || body.Content == "" {
+		t.Errorf("applied wisdom should be identified: %+v", body)
+	}
+	if body.Relevance <= 0.3 {
+		t.Errorf("match should clear the relevance threshold, got %f", body.Relevance)
+	}
+	if body.Effectiveness <= 0 || body.Outcome == "" {
+		t.Errorf("application result missing: %+v", body)
+	}
+
+	// A missing context is a bad request
+	empty, err := http.Post(server.URL+"/api/wisdom/apply", "application/json",
+		bytes.NewBufferString(`{}`))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	empty.Body.Close()
+	if empty.StatusCode != http.StatusBadRequest {
+		t.Errorf("missing context should return 400, got %d", empty.StatusCode)
+	}
+}
+
+// recordingLogHandler captures structured log records for assertions
+type recordingLogHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingLogHandler) Enabled(ctx context.Context, level slog.Level) bool { return true }
+
+func (h *recordingLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, record.Clone())
+	return nil
+}
+
+func (h *recordingLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler { return h }
+func (h *recordingLogHandler) WithGroup(name string) slog.Handler       { return h }
+
+// findByAttr returns the first record carrying the given attribute value
+func (h *recordingLogHandler) findByAttr(key, want string) (slog.Record, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, record := range h.records {
+		found := false
+		record.Attrs(func(attr slog.Attr) bool {
+			if attr.Key == key && attr.Value.String() == want {
+				found = true
+				return false
+			}
+			return true
+		})
+		if found {
+			return record, true
+		}
+	}
+	return slog.Record{}, false
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	recorder := &recordingLogHandler{}
+	logutil.SetLogger(slog.New(recorder))
+	t.Cleanup(func() { logutil.SetLogger(nil) })
+
+	platform := ecco9.NewPlatform(ecco9.DefaultConfiguration())
+	server := httptest.NewServer(setupRouter(platform, llm.NewProviderManager(), nil))
+	defer server.Close()
+
+	// A client-supplied ID is propagated to the response and the log
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/health", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	req.Header.Set("X-Request-ID", "corr-123")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := resp.Header.Get("X-Request-ID"); got != "corr-123" {
+		t.Errorf("client request ID should be propagated, got %q", got)
+	}
+
+	record, found := recorder.findByAttr("request_id", "corr-123")
+	if !found {
+		t.Fatal("no log record references the request ID")
+	}
+	if record.Message != "request completed" {
+		t.Errorf("unexpected log message: %q", record.Message)
+	}
+	attrs := map[string]string{}
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs[attr.Key] = attr.Value.String()
+		return true
+	})
+	if attrs["method"] != "GET" || attrs["path"] != "/api/health" || attrs["status"] != "200" {
+		t.Errorf("log record missing request fields: %v", attrs)
+	}
+
+	// Without a client ID, one is generated
+	generated, err := http.Get(server.URL + "/api/health")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	generated.Body.Close()
+
+	id := generated.Header.Get("X-Request-ID")
+	if id == "" {
+		t.Fatal("response should carry a generated request ID")
+	}
+	if _, found := recorder.findByAttr("request_id", id); !found {
+		t.Errorf("generated ID %q should appear in the log", id)
+	}
+}
+
+func TestRateLimiterEvictsIdleBuckets(t *testing.T) {
+	limiter := &ipRateLimiter{
+		rps:       100,
+		burst:     2,
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+
+	for i := 0; i < 50; i++ {
+		limiter.allow(fmt.Sprintf("10.0.0.%d", i))
+	}
+
+	limiter.mu.Lock()
+	if len(limiter.buckets) != 50 {
+		limiter.mu.Unlock()
+		t.Fatalf("expected 50 buckets before eviction, got %d", len(limiter.buckets))
+	}
+	// Backdate every bucket past the refill window so the next request
+	// sweeps them out
+	stale := time.Now().Add(-time.Minute)
+	for _, bucket := range limiter.buckets {
+		bucket.last = stale
+	}
+	limiter.lastSweep = stale
+	limiter.mu.Unlock()
+
+	if allowed, _ := limiter.allow("10.0.1.1"); !allowed {
+		t.Error("fresh client should be allowed")
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	if len(limiter.buckets) != 1 {
+		t.Errorf("idle buckets should be evicted, %d remain", len(limiter.buckets))
+	}
+	if _, exists := limiter.buckets["10.0.1.1"]; !exists {
+		t.Error("the active client's bucket must survive the sweep")
+	}
+}